@@ -0,0 +1,75 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+)
+
+// alicloudArn is the Alibaba Cloud Resource Name of a resource, of the form
+// acs:<service>:<region>:<account>:<resource type>/<resource id>. The region is
+// empty for global resources, such as RAM users.
+type alicloudArn struct {
+	Service      string
+	Region       string
+	AccountId    string
+	ResourceType string
+	ResourceId   string
+}
+
+// OSS resources have no resource type in their ARN, the resource is the
+// bucket name or the bucket name and the object key:
+// acs:oss:<region>:<account>:<bucket>[/<object key>]
+const arnServiceOss = "oss"
+
+// buildArn returns the ARN of a resource
+func buildArn(service string, region string, accountId string, resourceType string, resourceId string) string {
+	return alicloudArn{
+		Service:      service,
+		Region:       region,
+		AccountId:    accountId,
+		ResourceType: resourceType,
+		ResourceId:   resourceId,
+	}.String()
+}
+
+func (a alicloudArn) String() string {
+	resource := a.ResourceId
+	if a.ResourceType != "" && a.Service != arnServiceOss {
+		resource = a.ResourceType + "/" + a.ResourceId
+	}
+	return strings.Join([]string{"acs", a.Service, a.Region, a.AccountId, resource}, ":")
+}
+
+// parseArn parses an ARN. The "arn:" prefix used by some APIs, such as the Tag
+// API, is accepted.
+func parseArn(arn string) (*alicloudArn, error) {
+	parts := strings.SplitN(strings.TrimPrefix(arn, "arn:"), ":", 5)
+	if len(parts) != 5 || parts[0] != "acs" || parts[1] == "" || parts[4] == "" {
+		return nil, fmt.Errorf("invalid ARN %q, must be of the form acs:<service>:<region>:<account>:<resource type>/<resource id>", arn)
+	}
+
+	parsed := &alicloudArn{
+		Service:   parts[1],
+		Region:    parts[2],
+		AccountId: parts[3],
+	}
+
+	resource := parts[4]
+	if parsed.Service == arnServiceOss {
+		parsed.ResourceType = "bucket"
+		if strings.Contains(resource, "/") {
+			parsed.ResourceType = "object"
+		}
+		parsed.ResourceId = resource
+		return parsed, nil
+	}
+
+	// The resource id may contain slashes, for example the log stores of a
+	// project are project/<project>/logstore/<log store>
+	if resourceType, resourceId, found := strings.Cut(resource, "/"); found {
+		parsed.ResourceType, parsed.ResourceId = resourceType, resourceId
+	} else {
+		parsed.ResourceId = resource
+	}
+	return parsed, nil
+}
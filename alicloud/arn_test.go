@@ -0,0 +1,104 @@
+package alicloud
+
+import (
+	"testing"
+)
+
+func TestBuildArn(t *testing.T) {
+	tests := []struct {
+		name         string
+		service      string
+		region       string
+		accountId    string
+		resourceType string
+		resourceId   string
+		want         string
+	}{
+		{"regional resource", "ecs", "cn-hangzhou", "123", "instance", "i-1", "acs:ecs:cn-hangzhou:123:instance/i-1"},
+		{"global resource", "ram", "", "123", "user", "alice", "acs:ram::123:user/alice"},
+		{"account", "ram", "", "123", "", "root", "acs:ram::123:root"},
+		{"oss bucket", "oss", "cn-hangzhou", "123", "", "my-bucket", "acs:oss:cn-hangzhou:123:my-bucket"},
+		{"oss object", "oss", "cn-hangzhou", "123", "", "my-bucket/a/b.txt", "acs:oss:cn-hangzhou:123:my-bucket/a/b.txt"},
+		{"oss resource type is ignored", "oss", "cn-hangzhou", "123", "bucket", "my-bucket", "acs:oss:cn-hangzhou:123:my-bucket"},
+		{"log store", "log", "cn-hangzhou", "123", "project", "p/logstore/s", "acs:log:cn-hangzhou:123:project/p/logstore/s"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := buildArn(test.service, test.region, test.accountId, test.resourceType, test.resourceId); got != test.want {
+				t.Errorf("buildArn() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseArn(t *testing.T) {
+	tests := []struct {
+		name string
+		arn  string
+		want alicloudArn
+	}{
+		{"regional resource", "acs:ecs:cn-hangzhou:123:instance/i-1", alicloudArn{"ecs", "cn-hangzhou", "123", "instance", "i-1"}},
+		{"arn prefix", "arn:acs:ecs:cn-hangzhou:123:instance/i-1", alicloudArn{"ecs", "cn-hangzhou", "123", "instance", "i-1"}},
+		{"global resource", "acs:ram::123:user/alice", alicloudArn{"ram", "", "123", "user", "alice"}},
+		{"resource without type", "acs:ram::123:root", alicloudArn{"ram", "", "123", "", "root"}},
+		{"oss bucket", "acs:oss:cn-hangzhou:123:my-bucket", alicloudArn{"oss", "cn-hangzhou", "123", "bucket", "my-bucket"}},
+		{"oss object", "acs:oss:cn-hangzhou:123:my-bucket/a/b.txt", alicloudArn{"oss", "cn-hangzhou", "123", "object", "my-bucket/a/b.txt"}},
+		{"oss bucket without region", "acs:oss:::my-bucket", alicloudArn{"oss", "", "", "bucket", "my-bucket"}},
+		{"log store", "acs:log:cn-hangzhou:123:project/p/logstore/s", alicloudArn{"log", "cn-hangzhou", "123", "project", "p/logstore/s"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseArn(test.arn)
+			if err != nil {
+				t.Fatalf("parseArn() error = %v", err)
+			}
+			if *got != test.want {
+				t.Errorf("parseArn() = %+v, want %+v", *got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseArnRoundTrip(t *testing.T) {
+	arns := []alicloudArn{
+		{"ecs", "cn-hangzhou", "123", "instance", "i-1"},
+		{"ram", "", "123", "user", "alice"},
+		{"ram", "", "123", "policy", "my-policy"},
+		{"ram", "", "123", "", "root"},
+		{"resourcemanager", "", "123", "resourcegroup", "rg-1"},
+		{"oss", "cn-hangzhou", "123", "bucket", "my-bucket"},
+		{"oss", "cn-hangzhou", "123", "object", "my-bucket/a/b.txt"},
+		{"log", "cn-hangzhou", "123", "project", "p/logstore/s"},
+	}
+	for _, arn := range arns {
+		t.Run(arn.String(), func(t *testing.T) {
+			got, err := parseArn(arn.String())
+			if err != nil {
+				t.Fatalf("parseArn() error = %v", err)
+			}
+			if *got != arn {
+				t.Errorf("parseArn() = %+v, want %+v", *got, arn)
+			}
+		})
+	}
+}
+
+func TestParseArnInvalid(t *testing.T) {
+	arns := []string{
+		"",
+		"acs",
+		"i-1",
+		"acs:ecs:cn-hangzhou:123",
+		"acs:ecs:cn-hangzhou:123:",
+		"acs::cn-hangzhou:123:instance/i-1",
+		"aws:ecs:cn-hangzhou:123:instance/i-1",
+		"arn:aws:s3:::my-bucket",
+	}
+	for _, arn := range arns {
+		t.Run(arn, func(t *testing.T) {
+			if got, err := parseArn(arn); err == nil {
+				t.Errorf("parseArn() = %+v, want an error", *got)
+			}
+		})
+	}
+}
@@ -1,18 +1,35 @@
 package alicloud
 
 import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
 
 type alicloudConfig struct {
-	Regions          []string `hcl:"regions,optional"`
-	AccessKey        *string  `hcl:"access_key"`
-	SecretKey        *string  `hcl:"secret_key"`
-	IgnoreErrorCodes []string `hcl:"ignore_error_codes,optional"`
-	Profile          *string  `hcl:"profile"`
-	AutoRetry        *bool    `hcl:"auto_retry,optional"`
-	MaxRetryTime     *int     `hcl:"max_retry_time,optional"`
-	Timeout          *int     `hcl:"timeout,optional"`
+	Regions                []string          `hcl:"regions,optional"`
+	AccessKey              *string           `hcl:"access_key"`
+	SecretKey              *string           `hcl:"secret_key"`
+	IgnoreErrorCodes       []string          `hcl:"ignore_error_codes,optional"`
+	Profile                *string           `hcl:"profile"`
+	AutoRetry              *bool             `hcl:"auto_retry,optional"`
+	MaxRetryTime           *int              `hcl:"max_retry_time,optional"`
+	Timeout                *int              `hcl:"timeout,optional"`
+	CredentialReportMaxAge *int              `hcl:"credential_report_max_age,optional"`
+	Endpoints              map[string]string `hcl:"endpoints,optional"`
+	EndpointType           *string           `hcl:"endpoint_type,optional"`
+	Site                   *string           `hcl:"site,optional"`
+	HttpProxy              *string           `hcl:"http_proxy,optional"`
+	HttpsProxy             *string           `hcl:"https_proxy,optional"`
+	NoProxy                *string           `hcl:"no_proxy,optional"`
+	CaBundleFile           *string           `hcl:"ca_bundle_file,optional"`
+	InsecureSkipVerify     *bool             `hcl:"insecure_skip_verify,optional"`
 }
 
 func ConfigInstance() interface{} {
@@ -27,3 +44,113 @@ func GetConfig(connection *plugin.Connection) alicloudConfig {
 	config, _ := connection.Config.(alicloudConfig)
 	return config
 }
+
+// getValidConfig returns the connection config, or an error if it is invalid.
+// Queries which do not create a client, and so never get the credentials of
+// the connection, use it to reject an invalid config.
+func getValidConfig(d *plugin.QueryData) (alicloudConfig, error) {
+	config := GetConfig(d.Connection)
+	if err := validateConfig(config); err != nil {
+		return alicloudConfig{}, err
+	}
+	return config, nil
+}
+
+// validateConfig checks the connection config and returns an error listing
+// every invalid argument, or nil if the config is valid
+func validateConfig(config alicloudConfig) error {
+	var errs []error
+
+	if invalidRegions := getInvalidRegions(config.Regions); len(invalidRegions) > 0 {
+		errs = append(errs, fmt.Errorf("regions: invalid regions %s", strings.Join(invalidRegions, ", ")))
+	}
+
+	if config.Profile != nil && (config.AccessKey != nil || config.SecretKey != nil) {
+		errs = append(errs, errors.New("profile: cannot be set together with access_key and secret_key"))
+	}
+	if (config.AccessKey == nil) != (config.SecretKey == nil) {
+		errs = append(errs, errors.New("access_key, secret_key: must be set together"))
+	}
+
+	if config.MaxRetryTime != nil && *config.MaxRetryTime < 1 {
+		errs = append(errs, fmt.Errorf("max_retry_time: must be greater than or equal to 1, got %d", *config.MaxRetryTime))
+	}
+	if config.Timeout != nil && *config.Timeout < 1 {
+		errs = append(errs, fmt.Errorf("timeout: must be greater than or equal to 1, got %d", *config.Timeout))
+	}
+	if config.CredentialReportMaxAge != nil && *config.CredentialReportMaxAge < 0 {
+		errs = append(errs, fmt.Errorf("credential_report_max_age: must be greater than or equal to 0, got %d", *config.CredentialReportMaxAge))
+	}
+
+	for _, entry := range config.IgnoreErrorCodes {
+		if _, err := parseErrorCodePattern(entry); err != nil {
+			errs = append(errs, fmt.Errorf("ignore_error_codes: %v", err))
+		}
+	}
+
+	if config.EndpointType != nil {
+		switch *config.EndpointType {
+		case endpointTypePublic, endpointTypeVpc, endpointTypeIntl:
+		default:
+			errs = append(errs, fmt.Errorf("endpoint_type: must be one of public, vpc or intl, got %q", *config.EndpointType))
+		}
+	}
+	if config.Site != nil {
+		switch *config.Site {
+		case siteChina, siteInternational:
+		default:
+			errs = append(errs, fmt.Errorf("site: must be one of china or international, got %q", *config.Site))
+		}
+		if *config.Site == siteChina && config.EndpointType != nil && *config.EndpointType == endpointTypeIntl {
+			errs = append(errs, errors.New(`site: endpoint_type "intl" cannot be used with site "china"`))
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(config.Endpoints)) {
+		if err := validateEndpointKey(key); err != nil {
+			errs = append(errs, err)
+		}
+		if endpoint := config.Endpoints[key]; strings.TrimSpace(endpoint) == "" || strings.Contains(endpoint, "://") {
+			errs = append(errs, fmt.Errorf("endpoints: endpoint of %s must be a host name, got %q", key, endpoint))
+		}
+	}
+
+	if err := validateProxy("http_proxy", config.HttpProxy); err != nil {
+		errs = append(errs, err)
+	}
+	if err := validateProxy("https_proxy", config.HttpsProxy); err != nil {
+		errs = append(errs, err)
+	}
+	if config.CaBundleFile != nil {
+		if _, err := os.Stat(*config.CaBundleFile); err != nil {
+			errs = append(errs, fmt.Errorf("ca_bundle_file: %v", err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid connection config:\n%w\nEdit your connection configuration file and then restart Steampipe", errors.Join(errs...))
+	}
+	return nil
+}
+
+// validateEndpointKey checks that a key of the endpoints argument is a
+// service name, optionally followed by ":<region>"
+func validateEndpointKey(key string) error {
+	service, region, hasRegion := strings.Cut(key, ":")
+	if !slices.Contains(serviceNames, service) {
+		return fmt.Errorf("endpoints: unknown service %q in %q, must be one of %s", service, key, strings.Join(serviceNames, ", "))
+	}
+	if hasRegion && len(getInvalidRegions([]string{region})) > 0 {
+		return fmt.Errorf("endpoints: invalid region %q in %q", region, key)
+	}
+	return nil
+}
+
+func validateProxy(name string, proxy *string) error {
+	if proxy == nil {
+		return nil
+	}
+	if proxyURL, err := url.Parse(*proxy); err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+		return fmt.Errorf("%s: must be a URL such as http://proxy.example.com:3128, got %q", name, *proxy)
+	}
+	return nil
+}
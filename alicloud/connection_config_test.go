@@ -0,0 +1,30 @@
+package alicloud
+
+import (
+	"testing"
+)
+
+func TestValidateConfigEndpoints(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoints map[string]string
+		wantErr   bool
+	}{
+		{"service", map[string]string{"ecs": "ecs.example.com"}, false},
+		{"service in a region", map[string]string{"ecs:cn-hangzhou": "ecs-vpc.cn-hangzhou.aliyuncs.com"}, false},
+		{"unknown service", map[string]string{"ec2": "ec2.example.com"}, true},
+		{"unknown service in a region", map[string]string{"ec2:cn-hangzhou": "ec2.example.com"}, true},
+		{"invalid region", map[string]string{"ecs:us-east-9": "ecs.example.com"}, true},
+		{"empty region", map[string]string{"ecs:": "ecs.example.com"}, true},
+		{"URL", map[string]string{"ecs": "https://ecs.example.com"}, true},
+		{"empty endpoint", map[string]string{"ecs": " "}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateConfig(alicloudConfig{Endpoints: test.endpoints})
+			if (err != nil) != test.wantErr {
+				t.Errorf("validateConfig() error = %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -2,7 +2,18 @@ package alicloud
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+
+	alierrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibabacloud-oss-go-sdk-v2/oss"
+	sls "github.com/aliyun/aliyun-log-go-sdk"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
@@ -10,34 +21,264 @@ import (
 // isNotFoundError:: function which returns an ErrorPredicateWithContext for Alicloud API calls
 func isNotFoundError(notFoundErrors []string) plugin.ErrorPredicateWithContext {
 	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
-		alicloudConfig := GetConfig(d.Connection)
+		if matchErrorCodePatterns(ctx, d, err, notFoundErrors) {
+			return true
+		}
 
 		// If the get or list hydrate functions have an overriding IgnoreConfig
 		// defined using the isNotFoundError function, then it should
 		// also check for errors in the "ignore_error_codes" config argument
-		allErrors := append(notFoundErrors, alicloudConfig.IgnoreErrorCodes...)
-
-		// Added to support regex in not found errors
-		for _, pattern := range allErrors {
-			if strings.Contains(err.Error(), pattern) {
-				return true
-			}
-		}
-		return false
+		return shouldIgnoreConfigErrorCodes(ctx, d, err)
 	}
 }
 
 // shouldIgnoreErrorPluginDefault:: Plugin level default function to ignore a set errors for hydrate functions based on "ignore_error_codes" config argument
 func shouldIgnoreErrorPluginDefault() plugin.ErrorPredicateWithContext {
 	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
-		alicloudConfig := GetConfig(d.Connection)
+		return shouldIgnoreConfigErrorCodes(ctx, d, err)
+	}
+}
+
+// shouldIgnoreConfigErrorCodes returns true if an error matches the
+// "ignore_error_codes" config argument. Unlike not found errors, these errors
+// hide resources which exist, so they are recorded for the
+// alicloud_query_error table.
+func shouldIgnoreConfigErrorCodes(ctx context.Context, d *plugin.QueryData, err error) bool {
+	alicloudConfig := GetConfig(d.Connection)
+
+	if !matchErrorCodePatterns(ctx, d, err, alicloudConfig.IgnoreErrorCodes) {
+		return false
+	}
+	apiErr, _ := getAlicloudError(err)
+	recordQueryError(ctx, d, apiErr, err)
+	return true
+}
+
+// alicloudError is the error code and HTTP status returned by an Alicloud API
+type alicloudError struct {
+	Code   string
+	Status int
+}
+
+// getAlicloudError returns the error code and HTTP status of an error returned
+// by the core, OSS or Log Service SDKs. It returns false for any other error,
+// such as a network error.
+func getAlicloudError(err error) (alicloudError, bool) {
+	var serverErr *alierrors.ServerError
+	if errors.As(err, &serverErr) {
+		return alicloudError{Code: serverErr.ErrorCode(), Status: serverErr.HttpStatus()}, true
+	}
+	var ossErr *oss.ServiceError
+	if errors.As(err, &ossErr) {
+		return alicloudError{Code: ossErr.Code, Status: ossErr.StatusCode}, true
+	}
+	var slsErr *sls.Error
+	if errors.As(err, &slsErr) {
+		return alicloudError{Code: slsErr.Code, Status: int(slsErr.HTTPCode)}, true
+	}
+	// Client errors of the core SDK, such as SDK.TimeoutError, only have a code
+	var clientErr *alierrors.ClientError
+	if errors.As(err, &clientErr) {
+		return alicloudError{Code: clientErr.ErrorCode()}, true
+	}
+	return alicloudError{}, false
+}
+
+// errorCodePattern matches the errors ignored by an entry of the
+// "ignore_error_codes" config argument. An entry is either an error code, or a
+// list of key=value terms separated by semicolons, for example
+// "code=Forbidden.RAM;region=cn-beijing". The keys are code, status, region,
+// service and table, and every term must match. Values are exact strings,
+// globs using * and ?, or regular expressions prefixed with "regex:". A code
+// which is neither a glob nor a regular expression matches the codes starting
+// with it, as the entries were matched before patterns were supported, so
+// "Forbidden" matches "Forbidden.RAM".
+type errorCodePattern struct {
+	code    *valuePattern
+	status  *valuePattern
+	region  *valuePattern
+	service *valuePattern
+	table   *valuePattern
+}
+
+// valuePattern matches a single value of an error
+type valuePattern struct {
+	glob   string
+	prefix bool
+	regex  *regexp.Regexp
+}
+
+func (p *valuePattern) match(value string) bool {
+	if p.regex != nil {
+		return p.regex.MatchString(value)
+	}
+	if p.prefix {
+		return strings.HasPrefix(value, p.glob)
+	}
+	matched, _ := path.Match(p.glob, value)
+	return matched
+}
+
+// isLiteral returns true if the pattern is neither a glob nor a regular expression
+func (p *valuePattern) isLiteral() bool {
+	return p.regex == nil && !strings.ContainsAny(p.glob, `*?[\`)
+}
+
+func parseValuePattern(value string) (*valuePattern, error) {
+	if expr, ok := strings.CutPrefix(value, "regex:"); ok {
+		if _, err := regexp.Compile(expr); err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %v", expr, err)
+		}
+		// Anchor the expression, so that it matches the whole value like a glob
+		return &valuePattern{regex: regexp.MustCompile("^(?:" + expr + ")$")}, nil
+	}
+	if _, err := path.Match(value, ""); err != nil {
+		return nil, fmt.Errorf("invalid glob %q: %v", value, err)
+	}
+	return &valuePattern{glob: value}, nil
+}
+
+// parseErrorCodePattern parses an entry of the "ignore_error_codes" config argument
+func parseErrorCodePattern(entry string) (*errorCodePattern, error) {
+	if strings.TrimSpace(entry) == "" {
+		return nil, errors.New("error codes cannot be empty")
+	}
+
+	pattern := &errorCodePattern{}
+	for _, term := range strings.Split(entry, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(term), "=")
+		if !ok {
+			// A term without a key is an error code
+			key, value = "code", strings.TrimSpace(term)
+		}
+		if value == "" {
+			return nil, fmt.Errorf("%q: %s cannot be empty", entry, key)
+		}
 
-		// Added to support regex in ignoring errors
-		for _, pattern := range alicloudConfig.IgnoreErrorCodes {
-			if strings.Contains(err.Error(), pattern) {
-				return true
+		parsed, err := parseValuePattern(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %v", entry, err)
+		}
+
+		var field **valuePattern
+		switch key {
+		case "code":
+			parsed.prefix = parsed.isLiteral()
+			field = &pattern.code
+		case "status":
+			if parsed.isLiteral() {
+				if _, err := strconv.Atoi(value); err != nil {
+					return nil, fmt.Errorf("%q: status must be an HTTP status code, got %q", entry, value)
+				}
+			}
+			field = &pattern.status
+		case "region":
+			field = &pattern.region
+		case "service":
+			if parsed.isLiteral() && !slices.Contains(serviceNames, value) {
+				return nil, fmt.Errorf("%q: unknown service %q, must be one of %s", entry, value, strings.Join(serviceNames, ", "))
 			}
+			field = &pattern.service
+		case "table":
+			field = &pattern.table
+		default:
+			return nil, fmt.Errorf("%q: unknown key %q, must be one of code, status, region, service or table", entry, key)
+		}
+		if *field != nil {
+			return nil, fmt.Errorf("%q: %s is set more than once", entry, key)
+		}
+		*field = parsed
+	}
+	return pattern, nil
+}
+
+// errorCodePatterns caches the parsed entries of "ignore_error_codes" and of
+// the not found errors of the tables
+var errorCodePatterns sync.Map
+
+func getErrorCodePattern(entry string) (*errorCodePattern, error) {
+	if pattern, ok := errorCodePatterns.Load(entry); ok {
+		return pattern.(*errorCodePattern), nil
+	}
+	pattern, err := parseErrorCodePattern(entry)
+	if err != nil {
+		return nil, err
+	}
+	errorCodePatterns.Store(entry, pattern)
+	return pattern, nil
+}
+
+// matchErrorCodePatterns returns true if an error returned by an Alicloud API
+// matches any of the patterns
+func matchErrorCodePatterns(ctx context.Context, d *plugin.QueryData, err error, entries []string) bool {
+	apiErr, ok := getAlicloudError(err)
+	if !ok {
+		return false
+	}
+
+	for _, entry := range entries {
+		pattern, err := getErrorCodePattern(entry)
+		if err != nil {
+			// Invalid patterns are reported by validateConfig
+			plugin.Logger(ctx).Debug("matchErrorCodePatterns", "invalid_pattern", entry, "error", err)
+			continue
+		}
+		if pattern.match(d, apiErr) {
+			return true
 		}
+	}
+	return false
+}
+
+func (p *errorCodePattern) match(d *plugin.QueryData, apiErr alicloudError) bool {
+	if p.code != nil && !p.code.match(apiErr.Code) {
 		return false
 	}
+	if p.status != nil && !p.status.match(strconv.Itoa(apiErr.Status)) {
+		return false
+	}
+	if p.region != nil && !p.region.match(getErrorRegion(d)) {
+		return false
+	}
+	if p.service != nil && !slices.ContainsFunc(getTableServices(d.Table), p.service.match) {
+		return false
+	}
+	if p.table != nil && (d.Table == nil || !p.table.match(d.Table.Name)) {
+		return false
+	}
+	return true
+}
+
+// getErrorRegion returns the region of the query that returned an error. Tables
+// of global services are queried in the default region.
+func getErrorRegion(d *plugin.QueryData) string {
+	if region := d.EqualsQualString(matrixKeyRegion); region != "" {
+		return region
+	}
+	return GetDefaultRegion(d.Connection)
+}
+
+// getTableServices returns the services called by a table, from the service
+// tags of its hydrate functions. The tags use the names of serviceNames.
+func getTableServices(table *plugin.Table) []string {
+	if table == nil {
+		return nil
+	}
+
+	var services []string
+	addService := func(tags map[string]string) {
+		if service := tags["service"]; service != "" && !slices.Contains(services, service) {
+			services = append(services, service)
+		}
+	}
+	if table.List != nil {
+		addService(table.List.Tags)
+	}
+	if table.Get != nil {
+		addService(table.Get.Tags)
+	}
+	for _, hydrateConfig := range table.HydrateConfig {
+		addService(hydrateConfig.Tags)
+	}
+	return services
 }
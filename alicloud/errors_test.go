@@ -0,0 +1,198 @@
+package alicloud
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	alierrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibabacloud-oss-go-sdk-v2/oss"
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// newErrorQueryData returns the query data of a query of a table of a service
+// in a region
+func newErrorQueryData(tableName string, service string, region string) *plugin.QueryData {
+	return &plugin.QueryData{
+		Table: &plugin.Table{
+			Name: tableName,
+			List: &plugin.ListConfig{Tags: map[string]string{"service": service}},
+		},
+		EqualsQuals: plugin.KeyColumnEqualsQualMap{
+			matrixKeyRegion: &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: region}},
+		},
+	}
+}
+
+func TestErrorCodePatternMatch(t *testing.T) {
+	d := newErrorQueryData("alicloud_ecs_instance", "ecs", "cn-beijing")
+	forbidden := alicloudError{Code: "Forbidden.RAM", Status: 403}
+	throttled := alicloudError{Code: "Throttling.User", Status: 400}
+
+	tests := []struct {
+		entry  string
+		apiErr alicloudError
+		want   bool
+	}{
+		// Codes without a pattern match the codes starting with them
+		{"Forbidden.RAM", forbidden, true},
+		{"Forbidden", forbidden, true},
+		{"Forbidden.RAM.User", forbidden, false},
+		{"RAM", forbidden, false},
+		{"code=Forbidden", forbidden, true},
+		// Globs match the whole code
+		{"Forbidden.*", forbidden, true},
+		{"Forbidden.R?M", forbidden, true},
+		{"Forbidden.[RX]AM", forbidden, true},
+		{"*.RAM", forbidden, true},
+		{"Forb*", forbidden, true},
+		{"Forbidden.*", throttled, false},
+		{"Forbidden.R?", forbidden, false},
+		// Regular expressions match the whole code
+		{`regex:Forbidden\.(RAM|Access)`, forbidden, true},
+		{"regex:Forbidden", forbidden, false},
+		{"regex:Forbidden.*", forbidden, true},
+		{"regex:RAM", forbidden, false},
+		// Status
+		{"status=403", forbidden, true},
+		{"status=403", throttled, false},
+		{"status=4*", throttled, true},
+		{"status=regex:40[0-3]", forbidden, true},
+		{"status=40", forbidden, false},
+		// Region
+		{"region=cn-beijing", forbidden, true},
+		{"region=cn-*", forbidden, true},
+		{"region=cn-hangzhou", forbidden, false},
+		{"region=cn", forbidden, false},
+		// Service
+		{"service=ecs", forbidden, true},
+		{"service=e*", forbidden, true},
+		{"service=vpc", forbidden, false},
+		// Table
+		{"table=alicloud_ecs_instance", forbidden, true},
+		{"table=alicloud_ecs_*", forbidden, true},
+		{"table=regex:alicloud_(ecs|vpc)_.*", forbidden, true},
+		{"table=alicloud_ecs", forbidden, false},
+		// Every term must match
+		{"code=Forbidden.RAM;region=cn-beijing", forbidden, true},
+		{"code=Forbidden.RAM; region=cn-hangzhou", forbidden, false},
+		{"Forbidden.*;status=403;service=ecs;table=alicloud_ecs_instance", forbidden, true},
+		{"status=403;service=sas", forbidden, false},
+	}
+	for _, test := range tests {
+		t.Run(test.entry, func(t *testing.T) {
+			pattern, err := parseErrorCodePattern(test.entry)
+			if err != nil {
+				t.Fatalf("parseErrorCodePattern() error = %v", err)
+			}
+			if got := pattern.match(d, test.apiErr); got != test.want {
+				t.Errorf("match(%+v) = %v, want %v", test.apiErr, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseErrorCodePatternInvalid(t *testing.T) {
+	entries := []string{
+		"",
+		" ",
+		"code=",
+		"region=",
+		"Forbidden;",
+		"unknown=Forbidden",
+		"status=Forbidden",
+		"status=403;status=404",
+		"Forbidden;code=Throttling",
+		"regex:(Forbidden",
+		"code=regex:[",
+		"Forbidden.[",
+		"service=unknown",
+	}
+	for _, entry := range entries {
+		t.Run(entry, func(t *testing.T) {
+			if _, err := parseErrorCodePattern(entry); err == nil {
+				t.Errorf("parseErrorCodePattern(%q) returned no error", entry)
+			}
+		})
+	}
+}
+
+func TestMatchErrorCodePatterns(t *testing.T) {
+	ctx := newTestContext()
+	d := newErrorQueryData("alicloud_oss_bucket", "oss", "cn-hangzhou")
+
+	tests := []struct {
+		name    string
+		err     error
+		entries []string
+		want    bool
+	}{
+		{"server error", alierrors.NewServerError(403, `{"Code":"Forbidden.RAM"}`, ""), []string{"Forbidden"}, true},
+		{"wrapped server error", fmt.Errorf("list: %w", alierrors.NewServerError(403, `{"Code":"Forbidden.RAM"}`, "")), []string{"status=403"}, true},
+		{"client error", alierrors.NewClientError("SDK.TimeoutError", "timeout", nil), []string{"SDK.*"}, true},
+		{"oss error", &oss.ServiceError{Code: "AccessDenied", StatusCode: 403}, []string{"AccessDenied;service=oss"}, true},
+		{"log service error", &sls.Error{Code: "ProjectNotExist", HTTPCode: 404}, []string{"status=404"}, true},
+		{"no matching entry", alierrors.NewServerError(400, `{"Code":"Throttling.User"}`, ""), []string{"Forbidden", "status=403"}, false},
+		{"invalid entries are skipped", &oss.ServiceError{Code: "AccessDenied", StatusCode: 403}, []string{"regex:(", "AccessDenied"}, true},
+		{"message is not matched", alierrors.NewServerError(403, `{"Code":"Forbidden.RAM","Message":"AccessDenied"}`, ""), []string{"AccessDenied"}, false},
+		{"not an API error", errors.New("Forbidden.RAM"), []string{"Forbidden"}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := matchErrorCodePatterns(ctx, d, test.err, test.entries); got != test.want {
+				t.Errorf("matchErrorCodePatterns() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDefaultGetConfigIgnoresNotFoundErrors(t *testing.T) {
+	ctx := newTestContext()
+	d := newErrorQueryData("alicloud_ecs_instance", "ecs", "cn-hangzhou")
+	shouldIgnore := Plugin(ctx).DefaultGetConfig.IgnoreConfig.ShouldIgnoreErrorFunc
+
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"EntityNotExist.User", true},
+		{"EntityNotExists.ResourceDirectory", true},
+		{"InvalidInstanceId.NotFound", true},
+		{"ResourceNotFound", true},
+		{"ResourceNotFound.Instance", true},
+		{"NotFound.Bucket", true},
+		{"Forbidden.RAM", false},
+		{"Throttling.User", false},
+	}
+	for _, test := range tests {
+		t.Run(test.code, func(t *testing.T) {
+			err := alierrors.NewServerError(404, fmt.Sprintf(`{"Code":%q}`, test.code), "")
+			if got := shouldIgnore(ctx, d, nil, err); got != test.want {
+				t.Errorf("ShouldIgnoreErrorFunc(%s) = %v, want %v", test.code, got, test.want)
+			}
+		})
+	}
+}
+
+func TestTableServicesAreServiceNames(t *testing.T) {
+	for name, table := range newTestPlugin(newTestContext()).TableMap {
+		for _, service := range getTableServices(table) {
+			if !slices.Contains(serviceNames, service) {
+				t.Errorf("%s: service tag %q is not in serviceNames", name, service)
+			}
+		}
+	}
+}
+
+func TestServiceNamesAreEndpointServices(t *testing.T) {
+	var services []string
+	for _, service := range connectionDiagnosticServices {
+		services = append(services, service.Service)
+	}
+	if !slices.Equal(services, serviceNames) {
+		t.Errorf("the services of connectionDiagnosticServices are %v, want serviceNames %v", services, serviceNames)
+	}
+}
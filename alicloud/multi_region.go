@@ -3,7 +3,6 @@ package alicloud
 import (
 	"context"
 	"slices"
-	"strings"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
@@ -18,11 +17,8 @@ func BuildRegionList(_ context.Context, d *plugin.QueryData) []map[string]interf
 	if alicloudConfig.Regions != nil {
 		regions := GetConfig(d.Connection).Regions
 
-		if len(getInvalidRegions(regions)) > 0 {
-			panic("\n\nConnection config have invalid regions: " + strings.Join(getInvalidRegions(regions), ",") + ". Edit your connection configuration file and then restart Steampipe.")
-		}
-
-		// validate regions list
+		// invalid regions are reported by validateConfig when the clients
+		// of the services are created
 		matrix := make([]map[string]interface{}, len(regions))
 		for i, region := range regions {
 			matrix[i] = map[string]interface{}{matrixKeyRegion: region}
@@ -35,14 +31,24 @@ func BuildRegionList(_ context.Context, d *plugin.QueryData) []map[string]interf
 	}
 }
 
-func getInvalidRegions(regions []string) []string {
-	alicloudRegions := []string{
-		"cn-beijing", "cn-beijing-finance-1", "cn-chengdu", "cn-guangzhou", "cn-hangzhou", "cn-heyuan", "cn-hongkong", "cn-huhehaote", "cn-qingdao", "cn-shanghai", "cn-shanghai-finance-1", "cn-shenzhen", "cn-shenzhen-finance-1", "cn-wulanchabu", "cn-zhangjiakou", "ap-northeast-1", "ap-northeast-2", "ap-south-1", "ap-southeast-1", "ap-southeast-2", "ap-southeast-3", "ap-southeast-5", "ap-southeast-6", "ap-southeast-7", "eu-central-1", "eu-west-1", "me-east-1", "me-central-1", "us-east-1", "us-west-1", "cn-wuhan-lr", "cn-nanjing", "cn-fuzhou",
-	}
+// chinaRegions are the regions in the Chinese mainland, including the
+// finance regions
+var chinaRegions = []string{
+	"cn-beijing", "cn-beijing-finance-1", "cn-chengdu", "cn-fuzhou", "cn-guangzhou", "cn-hangzhou", "cn-heyuan", "cn-huhehaote", "cn-nanjing", "cn-qingdao", "cn-shanghai", "cn-shanghai-finance-1", "cn-shenzhen", "cn-shenzhen-finance-1", "cn-wuhan-lr", "cn-wulanchabu", "cn-zhangjiakou",
+}
+
+// internationalRegions are the regions outside the Chinese mainland
+var internationalRegions = []string{
+	"cn-hongkong", "ap-northeast-1", "ap-northeast-2", "ap-south-1", "ap-southeast-1", "ap-southeast-2", "ap-southeast-3", "ap-southeast-5", "ap-southeast-6", "ap-southeast-7", "eu-central-1", "eu-west-1", "me-east-1", "me-central-1", "us-east-1", "us-west-1",
+}
 
+// getInvalidRegions returns the regions that are not Alicloud regions. Accounts
+// of both the China and the international site can use the regions of the
+// other site, so the site of the connection does not restrict the regions.
+func getInvalidRegions(regions []string) []string {
 	invalidRegions := []string{}
 	for _, region := range regions {
-		if !slices.Contains(alicloudRegions, region) {
+		if !slices.Contains(chinaRegions, region) && !slices.Contains(internationalRegions, region) {
 			invalidRegions = append(invalidRegions, region)
 		}
 	}
@@ -0,0 +1,359 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+)
+
+// Network path evaluation helpers.
+//
+// The functions in this file evaluate security group, network ACL and route
+// table rules against a single flow without calling any API, so they can be
+// shared by the analysis tables. Endpoints given as a CIDR block are treated
+// as every address in the block: an accept rule must cover the whole block,
+// while a drop rule applies as soon as it overlaps it.
+
+const (
+	networkPathDecisionAllow         = "allow"
+	networkPathDecisionDeny          = "deny"
+	networkPathDecisionNotApplicable = "not_applicable"
+)
+
+// networkPathEndpoint is a resolved source or destination of a flow
+type networkPathEndpoint struct {
+	Type             string
+	ResourceId       string
+	Prefix           netip.Prefix
+	VpcId            string
+	VSwitchId        string
+	SecurityGroupIds []string
+}
+
+// networkPathFlow describes the traffic being evaluated
+type networkPathFlow struct {
+	Protocol string
+	Port     int
+}
+
+// networkPathEvaluation is the outcome of a single step along the path
+type networkPathEvaluation struct {
+	Step        string
+	ComponentId string
+	Decision    string
+	Reason      string
+	Rule        interface{}
+}
+
+// securityGroupRuleSet holds the rules and inner access policy of one security
+// group, and the CIDR blocks of the prefix lists referenced by its rules
+type securityGroupRuleSet struct {
+	SecurityGroupId   string
+	InnerAccessPolicy string
+	Permissions       []ecs.Permission
+	PrefixLists       map[string][]string
+}
+
+// parseNetworkPathPrefix parses a CIDR block or a single IP address
+func parseNetworkPathPrefix(value string) (netip.Prefix, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// networkPathPeerMatches checks a rule CIDR against an endpoint. Accept rules
+// must cover the whole endpoint block, drop rules only need to overlap it.
+func networkPathPeerMatches(ruleCidr string, endpoint netip.Prefix, accept bool) bool {
+	if ruleCidr == "" {
+		return false
+	}
+	rulePrefix, err := parseNetworkPathPrefix(ruleCidr)
+	if err != nil || rulePrefix.Addr().Is4() != endpoint.Addr().Is4() {
+		return false
+	}
+	if accept {
+		return rulePrefix.Bits() <= endpoint.Bits() && rulePrefix.Contains(endpoint.Addr())
+	}
+	return rulePrefix.Overlaps(endpoint)
+}
+
+// networkPathProtocolMatches checks a rule protocol and port range against the flow
+func networkPathProtocolMatches(ruleProtocol string, rulePortRange string, flow networkPathFlow, accept bool) bool {
+	ruleProtocol = strings.ToLower(ruleProtocol)
+
+	switch flow.Protocol {
+	case "all":
+		if !accept {
+			return true
+		}
+		if ruleProtocol != "all" {
+			return false
+		}
+		from, to, ok := parseSecurityGroupPortRange(ruleProtocol, rulePortRange)
+		return ok && from <= 1 && to >= 65535
+	case "tcp", "udp":
+		if ruleProtocol != "all" && ruleProtocol != flow.Protocol {
+			return false
+		}
+		from, to, ok := parseSecurityGroupPortRange(ruleProtocol, rulePortRange)
+		return ok && from <= flow.Port && flow.Port <= to
+	default:
+		return ruleProtocol == "all" || ruleProtocol == flow.Protocol
+	}
+}
+
+// evaluateSecurityGroups evaluates the security groups of an endpoint for the
+// given direction. Rules of all groups are pooled and ordered by priority, with
+// drop rules winning over accept rules of the same priority. When no rule
+// matches, traffic between members of a group whose inner access policy is
+// Accept is allowed, other inbound traffic is denied and outbound traffic is
+// allowed.
+func evaluateSecurityGroups(step string, direction string, groups []securityGroupRuleSet, peer networkPathEndpoint, flow networkPathFlow) networkPathEvaluation {
+	type candidate struct {
+		groupId    string
+		permission ecs.Permission
+		priority   int
+		accept     bool
+	}
+
+	var ids []string
+	var matches []candidate
+	for _, group := range groups {
+		ids = append(ids, group.SecurityGroupId)
+		for _, permission := range group.Permissions {
+			if !strings.EqualFold(permission.Direction, direction) {
+				continue
+			}
+			accept := strings.EqualFold(permission.Policy, "accept")
+			if !networkPathProtocolMatches(permission.IpProtocol, permission.PortRange, flow, accept) {
+				continue
+			}
+			if !securityGroupPeerMatches(direction, permission, group.PrefixLists, peer, accept) {
+				continue
+			}
+			priority, err := strconv.Atoi(permission.Priority)
+			if err != nil {
+				priority = 1
+			}
+			matches = append(matches, candidate{group.SecurityGroupId, permission, priority, accept})
+		}
+	}
+
+	evaluation := networkPathEvaluation{
+		Step:        step,
+		ComponentId: strings.Join(ids, ","),
+	}
+
+	if len(matches) > 0 {
+		sort.SliceStable(matches, func(i, j int) bool {
+			if matches[i].priority != matches[j].priority {
+				return matches[i].priority < matches[j].priority
+			}
+			return !matches[i].accept && matches[j].accept
+		})
+		decisive := matches[0]
+		evaluation.ComponentId = decisive.groupId
+		evaluation.Rule = decisive.permission
+		if decisive.accept {
+			evaluation.Decision = networkPathDecisionAllow
+			evaluation.Reason = fmt.Sprintf("%s rule %s of security group %s accepts the traffic", direction, securityGroupRuleName(decisive.permission), decisive.groupId)
+		} else {
+			evaluation.Decision = networkPathDecisionDeny
+			evaluation.Reason = fmt.Sprintf("%s rule %s of security group %s drops the traffic", direction, securityGroupRuleName(decisive.permission), decisive.groupId)
+		}
+		return evaluation
+	}
+
+	for _, group := range groups {
+		if strings.EqualFold(group.InnerAccessPolicy, "Accept") && slices.Contains(peer.SecurityGroupIds, group.SecurityGroupId) {
+			evaluation.ComponentId = group.SecurityGroupId
+			evaluation.Decision = networkPathDecisionAllow
+			evaluation.Reason = fmt.Sprintf("both endpoints belong to security group %s, whose inner access policy accepts the traffic", group.SecurityGroupId)
+			return evaluation
+		}
+	}
+
+	if direction == "egress" {
+		evaluation.Decision = networkPathDecisionAllow
+		evaluation.Reason = "no egress rule matches; outbound traffic is allowed by default"
+	} else {
+		evaluation.Decision = networkPathDecisionDeny
+		evaluation.Reason = "no ingress rule matches; inbound traffic is denied by default"
+	}
+	return evaluation
+}
+
+func securityGroupPeerMatches(direction string, permission ecs.Permission, prefixLists map[string][]string, peer networkPathEndpoint, accept bool) bool {
+	cidr, ipv6Cidr, groupId, prefixListId := permission.SourceCidrIp, permission.Ipv6SourceCidrIp, permission.SourceGroupId, permission.SourcePrefixListId
+	if direction == "egress" {
+		cidr, ipv6Cidr, groupId, prefixListId = permission.DestCidrIp, permission.Ipv6DestCidrIp, permission.DestGroupId, permission.DestPrefixListId
+	}
+
+	if groupId != "" {
+		return slices.Contains(peer.SecurityGroupIds, groupId)
+	}
+	// A prefix list rule applies to each of the CIDR blocks of the list
+	if prefixListId != "" {
+		for _, prefixListCidr := range prefixLists[prefixListId] {
+			if networkPathPeerMatches(prefixListCidr, peer.Prefix, accept) {
+				return true
+			}
+		}
+		return false
+	}
+	if peer.Prefix.Addr().Is6() {
+		return networkPathPeerMatches(ipv6Cidr, peer.Prefix, accept)
+	}
+	return networkPathPeerMatches(cidr, peer.Prefix, accept)
+}
+
+func securityGroupRuleName(permission ecs.Permission) string {
+	if permission.SecurityGroupRuleId != "" {
+		return permission.SecurityGroupRuleId
+	}
+	return permission.IpProtocol + " " + permission.PortRange
+}
+
+// evaluateNetworkAclIngress evaluates inbound network ACL entries in order; the
+// first matching entry decides, and traffic matching no entry is denied
+func evaluateNetworkAclIngress(step string, networkAclId string, entries []vpc.IngressAclEntry, peer networkPathEndpoint, flow networkPathFlow) networkPathEvaluation {
+	evaluation := networkPathEvaluation{Step: step, ComponentId: networkAclId}
+	for _, entry := range entries {
+		accept := strings.EqualFold(entry.Policy, "accept")
+		if !networkPathProtocolMatches(entry.Protocol, entry.Port, flow, accept) || !networkPathPeerMatches(entry.SourceCidrIp, peer.Prefix, accept) {
+			continue
+		}
+		return networkAclDecision(evaluation, entry.NetworkAclEntryId, entry.NetworkAclEntryName, accept, entry)
+	}
+	evaluation.Decision = networkPathDecisionDeny
+	evaluation.Reason = fmt.Sprintf("no inbound entry of network ACL %s matches the traffic", networkAclId)
+	return evaluation
+}
+
+// evaluateNetworkAclEgress evaluates outbound network ACL entries in order; the
+// first matching entry decides, and traffic matching no entry is denied
+func evaluateNetworkAclEgress(step string, networkAclId string, entries []vpc.EgressAclEntry, peer networkPathEndpoint, flow networkPathFlow) networkPathEvaluation {
+	evaluation := networkPathEvaluation{Step: step, ComponentId: networkAclId}
+	for _, entry := range entries {
+		accept := strings.EqualFold(entry.Policy, "accept")
+		if !networkPathProtocolMatches(entry.Protocol, entry.Port, flow, accept) || !networkPathPeerMatches(entry.DestinationCidrIp, peer.Prefix, accept) {
+			continue
+		}
+		return networkAclDecision(evaluation, entry.NetworkAclEntryId, entry.NetworkAclEntryName, accept, entry)
+	}
+	evaluation.Decision = networkPathDecisionDeny
+	evaluation.Reason = fmt.Sprintf("no outbound entry of network ACL %s matches the traffic", networkAclId)
+	return evaluation
+}
+
+func networkAclDecision(evaluation networkPathEvaluation, entryId string, entryName string, accept bool, rule interface{}) networkPathEvaluation {
+	name := entryId
+	if entryName != "" {
+		name = entryName
+	}
+	evaluation.Rule = rule
+	if accept {
+		evaluation.Decision = networkPathDecisionAllow
+		evaluation.Reason = fmt.Sprintf("entry %s of network ACL %s accepts the traffic", name, evaluation.ComponentId)
+	} else {
+		evaluation.Decision = networkPathDecisionDeny
+		evaluation.Reason = fmt.Sprintf("entry %s of network ACL %s drops the traffic", name, evaluation.ComponentId)
+	}
+	return evaluation
+}
+
+// networkPathCrossVpcNextHopTypes are the next hop types of the routes that
+// reach another VPC: VPC peering connections, router interfaces, CEN
+// attachments and VPN gateways
+var networkPathCrossVpcNextHopTypes = []string{"VpcPeer", "RouterInterface", "Attachment", "CenBasic", "VpnGateway"}
+
+// evaluateRoute picks the most specific route entry covering the destination.
+// Destinations inside the VPC CIDR blocks are reached through the local route.
+// When the destination is in another VPC, given by destinationVpcId, it is
+// only reached through a route to a VPC peering connection, CEN or VPN
+// gateway; the local route and other next hops, such as a NAT gateway, keep
+// the traffic away from that VPC.
+func evaluateRoute(step string, routeTableId string, vpcCidrs []string, entries []vpc.RouteEntry, destination netip.Prefix, destinationVpcId string) networkPathEvaluation {
+	evaluation := networkPathEvaluation{Step: step, ComponentId: routeTableId}
+
+	var best *vpc.RouteEntry
+	bestBits := -1
+	for i, entry := range entries {
+		prefix, err := parseNetworkPathPrefix(entry.DestinationCidrBlock)
+		if err != nil || prefix.Addr().Is4() != destination.Addr().Is4() {
+			continue
+		}
+		if prefix.Bits() <= destination.Bits() && prefix.Contains(destination.Addr()) && prefix.Bits() > bestBits {
+			best = &entries[i]
+			bestBits = prefix.Bits()
+		}
+	}
+
+	for _, cidr := range vpcCidrs {
+		prefix, err := parseNetworkPathPrefix(cidr)
+		if err != nil || prefix.Addr().Is4() != destination.Addr().Is4() {
+			continue
+		}
+		if prefix.Bits() <= destination.Bits() && prefix.Contains(destination.Addr()) && prefix.Bits() >= bestBits {
+			if destinationVpcId != "" {
+				evaluation.Decision = networkPathDecisionDeny
+				evaluation.Reason = fmt.Sprintf("destination is in VPC %s, but its address is inside CIDR block %s of the source VPC and the local route keeps the traffic in the source VPC", destinationVpcId, cidr)
+				return evaluation
+			}
+			evaluation.Decision = networkPathDecisionAllow
+			evaluation.Reason = fmt.Sprintf("destination is inside VPC CIDR block %s and is reached through the local route", cidr)
+			return evaluation
+		}
+	}
+
+	if best == nil {
+		evaluation.Decision = networkPathDecisionDeny
+		evaluation.Reason = fmt.Sprintf("route table %s has no route to the destination", routeTableId)
+		return evaluation
+	}
+
+	evaluation.Rule = *best
+	nextHop := best.InstanceId
+	if nextHop == "" {
+		nextHop = best.NextHopType
+	}
+	if destinationVpcId != "" && !slices.Contains(networkPathCrossVpcNextHopTypes, best.NextHopType) {
+		evaluation.Decision = networkPathDecisionDeny
+		evaluation.Reason = fmt.Sprintf("destination is in VPC %s, but route %s of route table %s forwards the traffic to %s (%s), which is not a VPC peering connection, CEN or VPN gateway", destinationVpcId, best.DestinationCidrBlock, routeTableId, nextHop, best.NextHopType)
+		return evaluation
+	}
+	evaluation.Decision = networkPathDecisionAllow
+	evaluation.Reason = fmt.Sprintf("route %s of route table %s forwards the traffic to %s (%s)", best.DestinationCidrBlock, routeTableId, nextHop, best.NextHopType)
+	return evaluation
+}
+
+// networkPathDecide combines the step evaluations: the first denying step
+// decides, otherwise the last applicable step allows the traffic
+func networkPathDecide(evaluations []networkPathEvaluation) (bool, *networkPathEvaluation) {
+	var last *networkPathEvaluation
+	for i := range evaluations {
+		switch evaluations[i].Decision {
+		case networkPathDecisionDeny:
+			return false, &evaluations[i]
+		case networkPathDecisionAllow:
+			last = &evaluations[i]
+		}
+	}
+	return true, last
+}
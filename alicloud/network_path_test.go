@@ -0,0 +1,393 @@
+package alicloud
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func mustParseNetworkPathPrefix(t *testing.T, value string) netip.Prefix {
+	t.Helper()
+	prefix, err := parseNetworkPathPrefix(value)
+	if err != nil {
+		t.Fatalf("parseNetworkPathPrefix(%q) returned error: %v", value, err)
+	}
+	return prefix
+}
+
+func TestParseNetworkPathPrefix(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "10.0.0.5", want: "10.0.0.5/32"},
+		{value: " 10.0.1.7/24 ", want: "10.0.1.0/24"},
+		{value: "2408:4000::1", want: "2408:4000::1/128"},
+		{value: "2408:4000::/32", want: "2408:4000::/32"},
+		{value: "10.0.0.0/33", wantErr: true},
+		{value: "i-bp1234", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := parseNetworkPathPrefix(test.value)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("parseNetworkPathPrefix(%q) = %s, want an error", test.value, got)
+			}
+			continue
+		}
+		if err != nil || got.String() != test.want {
+			t.Errorf("parseNetworkPathPrefix(%q) = %s, %v, want %s", test.value, got, err, test.want)
+		}
+	}
+}
+
+func TestNetworkPathPeerMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		ruleCidr string
+		endpoint string
+		accept   bool
+		want     bool
+	}{
+		{name: "accept covering address", ruleCidr: "10.0.0.0/16", endpoint: "10.0.3.4", accept: true, want: true},
+		{name: "accept covering block", ruleCidr: "10.0.0.0/16", endpoint: "10.0.3.0/24", accept: true, want: true},
+		{name: "accept partially covering block", ruleCidr: "10.0.3.0/25", endpoint: "10.0.3.0/24", accept: true, want: false},
+		{name: "drop overlapping block", ruleCidr: "10.0.3.0/25", endpoint: "10.0.3.0/24", accept: false, want: true},
+		{name: "disjoint", ruleCidr: "192.168.0.0/16", endpoint: "10.0.3.4", accept: false, want: false},
+		{name: "empty rule", ruleCidr: "", endpoint: "10.0.3.4", accept: true, want: false},
+		{name: "ip version mismatch", ruleCidr: "::/0", endpoint: "10.0.3.4", accept: true, want: false},
+		{name: "any ipv4", ruleCidr: "0.0.0.0/0", endpoint: "47.98.1.2", accept: true, want: true},
+	}
+	for _, test := range tests {
+		got := networkPathPeerMatches(test.ruleCidr, mustParseNetworkPathPrefix(t, test.endpoint), test.accept)
+		if got != test.want {
+			t.Errorf("%s: networkPathPeerMatches(%q, %q, %v) = %v, want %v", test.name, test.ruleCidr, test.endpoint, test.accept, got, test.want)
+		}
+	}
+}
+
+func TestNetworkPathProtocolMatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		protocol  string
+		portRange string
+		flow      networkPathFlow
+		accept    bool
+		want      bool
+	}{
+		{name: "tcp port in range", protocol: "TCP", portRange: "20/25", flow: networkPathFlow{Protocol: "tcp", Port: 22}, accept: true, want: true},
+		{name: "tcp port out of range", protocol: "TCP", portRange: "80/80", flow: networkPathFlow{Protocol: "tcp", Port: 22}, accept: true, want: false},
+		{name: "udp rule for tcp flow", protocol: "UDP", portRange: "1/65535", flow: networkPathFlow{Protocol: "tcp", Port: 22}, accept: true, want: false},
+		{name: "all rule for tcp flow", protocol: "ALL", portRange: "-1/-1", flow: networkPathFlow{Protocol: "tcp", Port: 22}, accept: true, want: true},
+		{name: "icmp flow", protocol: "ICMP", portRange: "-1/-1", flow: networkPathFlow{Protocol: "icmp"}, accept: true, want: true},
+		{name: "tcp rule for icmp flow", protocol: "TCP", portRange: "1/65535", flow: networkPathFlow{Protocol: "icmp"}, accept: true, want: false},
+		{name: "all flow accepted by all rule", protocol: "ALL", portRange: "-1/-1", flow: networkPathFlow{Protocol: "all"}, accept: true, want: true},
+		{name: "all flow not accepted by tcp rule", protocol: "TCP", portRange: "1/65535", flow: networkPathFlow{Protocol: "all"}, accept: true, want: false},
+		{name: "all flow dropped by tcp rule", protocol: "TCP", portRange: "22/22", flow: networkPathFlow{Protocol: "all"}, accept: false, want: true},
+	}
+	for _, test := range tests {
+		got := networkPathProtocolMatches(test.protocol, test.portRange, test.flow, test.accept)
+		if got != test.want {
+			t.Errorf("%s: networkPathProtocolMatches(%q, %q, %+v, %v) = %v, want %v", test.name, test.protocol, test.portRange, test.flow, test.accept, got, test.want)
+		}
+	}
+}
+
+func TestEvaluateSecurityGroups(t *testing.T) {
+	ssh := networkPathFlow{Protocol: "tcp", Port: 22}
+	peer := networkPathEndpoint{
+		Type:             "instance",
+		Prefix:           mustParseNetworkPathPrefix(t, "10.0.1.10"),
+		SecurityGroupIds: []string{"sg-peer"},
+	}
+
+	tests := []struct {
+		name      string
+		direction string
+		groups    []securityGroupRuleSet
+		want      string
+		wantGroup string
+		wantRule  string
+	}{
+		{
+			name:      "accept rule",
+			direction: "ingress",
+			groups: []securityGroupRuleSet{{
+				SecurityGroupId: "sg-1",
+				Permissions: []ecs.Permission{
+					{SecurityGroupRuleId: "sgr-accept", Direction: "ingress", Policy: "Accept", IpProtocol: "TCP", PortRange: "22/22", SourceCidrIp: "10.0.0.0/16", Priority: "1"},
+				},
+			}},
+			want:      networkPathDecisionAllow,
+			wantGroup: "sg-1",
+			wantRule:  "sgr-accept",
+		},
+		{
+			name:      "drop rule with higher priority",
+			direction: "ingress",
+			groups: []securityGroupRuleSet{{
+				SecurityGroupId: "sg-1",
+				Permissions: []ecs.Permission{
+					{SecurityGroupRuleId: "sgr-accept", Direction: "ingress", Policy: "Accept", IpProtocol: "TCP", PortRange: "22/22", SourceCidrIp: "10.0.0.0/16", Priority: "10"},
+					{SecurityGroupRuleId: "sgr-drop", Direction: "ingress", Policy: "Drop", IpProtocol: "TCP", PortRange: "22/22", SourceCidrIp: "10.0.1.0/24", Priority: "5"},
+				},
+			}},
+			want:      networkPathDecisionDeny,
+			wantGroup: "sg-1",
+			wantRule:  "sgr-drop",
+		},
+		{
+			name:      "drop rule wins over accept rule of the same priority across groups",
+			direction: "ingress",
+			groups: []securityGroupRuleSet{
+				{
+					SecurityGroupId: "sg-1",
+					Permissions: []ecs.Permission{
+						{SecurityGroupRuleId: "sgr-accept", Direction: "ingress", Policy: "Accept", IpProtocol: "ALL", PortRange: "-1/-1", SourceCidrIp: "0.0.0.0/0", Priority: "1"},
+					},
+				},
+				{
+					SecurityGroupId: "sg-2",
+					Permissions: []ecs.Permission{
+						{SecurityGroupRuleId: "sgr-drop", Direction: "ingress", Policy: "Drop", IpProtocol: "TCP", PortRange: "22/22", SourceCidrIp: "10.0.1.10/32", Priority: "1"},
+					},
+				},
+			},
+			want:      networkPathDecisionDeny,
+			wantGroup: "sg-2",
+			wantRule:  "sgr-drop",
+		},
+		{
+			name:      "rule of the other direction is ignored",
+			direction: "ingress",
+			groups: []securityGroupRuleSet{{
+				SecurityGroupId: "sg-1",
+				Permissions: []ecs.Permission{
+					{SecurityGroupRuleId: "sgr-egress", Direction: "egress", Policy: "Accept", IpProtocol: "TCP", PortRange: "22/22", DestCidrIp: "10.0.0.0/16", Priority: "1"},
+				},
+			}},
+			want:      networkPathDecisionDeny,
+			wantGroup: "sg-1",
+		},
+		{
+			name:      "rule referencing the security group of the peer",
+			direction: "ingress",
+			groups: []securityGroupRuleSet{{
+				SecurityGroupId: "sg-1",
+				Permissions: []ecs.Permission{
+					{SecurityGroupRuleId: "sgr-group", Direction: "ingress", Policy: "Accept", IpProtocol: "TCP", PortRange: "22/22", SourceGroupId: "sg-peer", Priority: "1"},
+				},
+			}},
+			want:      networkPathDecisionAllow,
+			wantGroup: "sg-1",
+			wantRule:  "sgr-group",
+		},
+		{
+			name:      "rule referencing a prefix list containing the peer",
+			direction: "ingress",
+			groups: []securityGroupRuleSet{{
+				SecurityGroupId: "sg-1",
+				Permissions: []ecs.Permission{
+					{SecurityGroupRuleId: "sgr-prefix-list", Direction: "ingress", Policy: "Accept", IpProtocol: "TCP", PortRange: "22/22", SourcePrefixListId: "pl-1", Priority: "1"},
+				},
+				PrefixLists: map[string][]string{"pl-1": {"192.168.0.0/16", "10.0.1.0/24"}},
+			}},
+			want:      networkPathDecisionAllow,
+			wantGroup: "sg-1",
+			wantRule:  "sgr-prefix-list",
+		},
+		{
+			name:      "rule referencing a prefix list not containing the peer",
+			direction: "ingress",
+			groups: []securityGroupRuleSet{{
+				SecurityGroupId: "sg-1",
+				Permissions: []ecs.Permission{
+					{SecurityGroupRuleId: "sgr-prefix-list", Direction: "ingress", Policy: "Accept", IpProtocol: "TCP", PortRange: "22/22", SourcePrefixListId: "pl-1", Priority: "1"},
+				},
+				PrefixLists: map[string][]string{"pl-1": {"192.168.0.0/16"}},
+			}},
+			want:      networkPathDecisionDeny,
+			wantGroup: "sg-1",
+		},
+		{
+			name:      "egress rule referencing a prefix list",
+			direction: "egress",
+			groups: []securityGroupRuleSet{{
+				SecurityGroupId: "sg-1",
+				Permissions: []ecs.Permission{
+					{SecurityGroupRuleId: "sgr-prefix-list", Direction: "egress", Policy: "Drop", IpProtocol: "TCP", PortRange: "22/22", DestPrefixListId: "pl-1", Priority: "1"},
+				},
+				PrefixLists: map[string][]string{"pl-1": {"10.0.0.0/8"}},
+			}},
+			want:      networkPathDecisionDeny,
+			wantGroup: "sg-1",
+			wantRule:  "sgr-prefix-list",
+		},
+		{
+			name:      "inner access policy between members",
+			direction: "ingress",
+			groups: []securityGroupRuleSet{{
+				SecurityGroupId:   "sg-peer",
+				InnerAccessPolicy: "Accept",
+			}},
+			want:      networkPathDecisionAllow,
+			wantGroup: "sg-peer",
+		},
+		{
+			name:      "no ingress rule",
+			direction: "ingress",
+			groups:    []securityGroupRuleSet{{SecurityGroupId: "sg-1"}},
+			want:      networkPathDecisionDeny,
+			wantGroup: "sg-1",
+		},
+		{
+			name:      "no egress rule",
+			direction: "egress",
+			groups:    []securityGroupRuleSet{{SecurityGroupId: "sg-1"}},
+			want:      networkPathDecisionAllow,
+			wantGroup: "sg-1",
+		},
+	}
+	for _, test := range tests {
+		got := evaluateSecurityGroups("step", test.direction, test.groups, peer, ssh)
+		if got.Decision != test.want || got.ComponentId != test.wantGroup {
+			t.Errorf("%s: got decision %s from %s, want %s from %s (%s)", test.name, got.Decision, got.ComponentId, test.want, test.wantGroup, got.Reason)
+		}
+		rule := ""
+		if permission, ok := got.Rule.(ecs.Permission); ok {
+			rule = permission.SecurityGroupRuleId
+		}
+		if rule != test.wantRule {
+			t.Errorf("%s: got rule %q, want %q", test.name, rule, test.wantRule)
+		}
+	}
+}
+
+func TestEvaluateNetworkAcl(t *testing.T) {
+	https := networkPathFlow{Protocol: "tcp", Port: 443}
+	peer := networkPathEndpoint{Prefix: mustParseNetworkPathPrefix(t, "10.0.2.10")}
+
+	ingress := []vpc.IngressAclEntry{
+		{NetworkAclEntryId: "nae-drop", Policy: "drop", Protocol: "tcp", Port: "443/443", SourceCidrIp: "10.0.3.0/24"},
+		{NetworkAclEntryId: "nae-accept", NetworkAclEntryName: "allow-vpc", Policy: "accept", Protocol: "tcp", Port: "1/65535", SourceCidrIp: "10.0.0.0/16"},
+		{NetworkAclEntryId: "nae-drop-all", Policy: "drop", Protocol: "all", Port: "-1/-1", SourceCidrIp: "0.0.0.0/0"},
+	}
+	got := evaluateNetworkAclIngress("step", "nacl-1", ingress, peer, https)
+	if got.Decision != networkPathDecisionAllow || got.Rule.(vpc.IngressAclEntry).NetworkAclEntryId != "nae-accept" {
+		t.Errorf("ingress: got %s (%s), want allow by nae-accept", got.Decision, got.Reason)
+	}
+
+	got = evaluateNetworkAclIngress("step", "nacl-1", ingress[2:], peer, https)
+	if got.Decision != networkPathDecisionDeny || got.Rule.(vpc.IngressAclEntry).NetworkAclEntryId != "nae-drop-all" {
+		t.Errorf("ingress drop: got %s (%s), want deny by nae-drop-all", got.Decision, got.Reason)
+	}
+
+	got = evaluateNetworkAclIngress("step", "nacl-1", nil, peer, https)
+	if got.Decision != networkPathDecisionDeny || got.Rule != nil {
+		t.Errorf("ingress without entries: got %s (%s), want deny", got.Decision, got.Reason)
+	}
+
+	egress := []vpc.EgressAclEntry{
+		{NetworkAclEntryId: "nae-udp", Policy: "accept", Protocol: "udp", Port: "1/65535", DestinationCidrIp: "0.0.0.0/0"},
+		{NetworkAclEntryId: "nae-drop", Policy: "drop", Protocol: "tcp", Port: "443/443", DestinationCidrIp: "10.0.2.0/24"},
+	}
+	got = evaluateNetworkAclEgress("step", "nacl-1", egress, peer, https)
+	if got.Decision != networkPathDecisionDeny || got.Rule.(vpc.EgressAclEntry).NetworkAclEntryId != "nae-drop" {
+		t.Errorf("egress: got %s (%s), want deny by nae-drop", got.Decision, got.Reason)
+	}
+}
+
+func TestEvaluateRoute(t *testing.T) {
+	entries := []vpc.RouteEntry{
+		{DestinationCidrBlock: "0.0.0.0/0", NextHopType: "NatGateway", InstanceId: "ngw-1"},
+		{DestinationCidrBlock: "172.16.0.0/12", NextHopType: "VpcPeer", InstanceId: "pcc-1"},
+		{DestinationCidrBlock: "172.16.5.0/24", NextHopType: "Instance", InstanceId: "i-router"},
+		{DestinationCidrBlock: "192.168.0.0/16", NextHopType: "Attachment", InstanceId: "tr-attach-1"},
+		{DestinationCidrBlock: "192.168.9.0/24", NextHopType: "VpnGateway", InstanceId: "vpn-1"},
+	}
+	vpcCidrs := []string{"10.0.0.0/16"}
+
+	tests := []struct {
+		name             string
+		entries          []vpc.RouteEntry
+		destination      string
+		destinationVpcId string
+		want             string
+		wantRoute        string
+	}{
+		{name: "local route", entries: entries, destination: "10.0.4.2", want: networkPathDecisionAllow},
+		{name: "most specific route", entries: entries, destination: "172.16.5.9", want: networkPathDecisionAllow, wantRoute: "172.16.5.0/24"},
+		{name: "less specific route", entries: entries, destination: "172.17.0.1", want: networkPathDecisionAllow, wantRoute: "172.16.0.0/12"},
+		{name: "default route", entries: entries, destination: "47.98.1.2", want: networkPathDecisionAllow, wantRoute: "0.0.0.0/0"},
+		{name: "block not covered by a single route", entries: entries[1:], destination: "172.0.0.0/8", want: networkPathDecisionDeny},
+		{name: "no route", entries: nil, destination: "47.98.1.2", want: networkPathDecisionDeny},
+		// Destinations in another VPC
+		{name: "other VPC through a peering connection", entries: entries, destination: "172.17.0.1", destinationVpcId: "vpc-2", want: networkPathDecisionAllow, wantRoute: "172.16.0.0/12"},
+		{name: "other VPC through CEN", entries: entries, destination: "192.168.1.1", destinationVpcId: "vpc-2", want: networkPathDecisionAllow, wantRoute: "192.168.0.0/16"},
+		{name: "other VPC through a VPN gateway", entries: entries, destination: "192.168.9.1", destinationVpcId: "vpc-2", want: networkPathDecisionAllow, wantRoute: "192.168.9.0/24"},
+		{name: "other VPC through a NAT gateway", entries: entries, destination: "100.64.0.1", destinationVpcId: "vpc-2", want: networkPathDecisionDeny, wantRoute: "0.0.0.0/0"},
+		{name: "other VPC through an instance", entries: entries, destination: "172.16.5.9", destinationVpcId: "vpc-2", want: networkPathDecisionDeny, wantRoute: "172.16.5.0/24"},
+		{name: "other VPC with an overlapping CIDR block", entries: entries, destination: "10.0.4.2", destinationVpcId: "vpc-2", want: networkPathDecisionDeny},
+		{name: "other VPC without a route", entries: nil, destination: "172.17.0.1", destinationVpcId: "vpc-2", want: networkPathDecisionDeny},
+	}
+	for _, test := range tests {
+		got := evaluateRoute("route", "vtb-1", vpcCidrs, test.entries, mustParseNetworkPathPrefix(t, test.destination), test.destinationVpcId)
+		route := ""
+		if entry, ok := got.Rule.(vpc.RouteEntry); ok {
+			route = entry.DestinationCidrBlock
+		}
+		if got.Decision != test.want || route != test.wantRoute {
+			t.Errorf("%s: got %s by route %q, want %s by route %q (%s)", test.name, got.Decision, route, test.want, test.wantRoute, got.Reason)
+		}
+	}
+}
+
+func TestNetworkPathDecide(t *testing.T) {
+	allow := networkPathEvaluation{Step: "allow", Decision: networkPathDecisionAllow}
+	deny := networkPathEvaluation{Step: "deny", Decision: networkPathDecisionDeny}
+	notApplicable := networkPathEvaluation{Step: "not_applicable", Decision: networkPathDecisionNotApplicable}
+	lastAllow := networkPathEvaluation{Step: "last_allow", Decision: networkPathDecisionAllow}
+
+	tests := []struct {
+		name        string
+		evaluations []networkPathEvaluation
+		wantAllowed bool
+		wantStep    string
+	}{
+		{name: "all allow", evaluations: []networkPathEvaluation{allow, notApplicable, lastAllow, notApplicable}, wantAllowed: true, wantStep: "last_allow"},
+		{name: "first deny decides", evaluations: []networkPathEvaluation{allow, deny, lastAllow}, wantAllowed: false, wantStep: "deny"},
+		{name: "nothing applicable", evaluations: []networkPathEvaluation{notApplicable}, wantAllowed: true},
+	}
+	for _, test := range tests {
+		allowed, decisive := networkPathDecide(test.evaluations)
+		step := ""
+		if decisive != nil {
+			step = decisive.Step
+		}
+		if allowed != test.wantAllowed || step != test.wantStep {
+			t.Errorf("%s: got %v decided by %q, want %v decided by %q", test.name, allowed, step, test.wantAllowed, test.wantStep)
+		}
+	}
+}
+
+func TestSecurityGroupCacheGet(t *testing.T) {
+	cache := securityGroupCache{
+		"sg-1": {SecurityGroupId: "sg-1", InnerAccessPolicy: "Accept"},
+		"sg-2": {SecurityGroupId: "sg-2", InnerAccessPolicy: "Drop"},
+	}
+
+	// Cached groups are returned in the given order without calling the API
+	groups, err := cache.get(context.Background(), &plugin.QueryData{}, nil, []string{"sg-2", "sg-1"})
+	if err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+	if len(groups) != 2 || groups[0].SecurityGroupId != "sg-2" || groups[1].SecurityGroupId != "sg-1" {
+		t.Errorf("get() = %+v, want sg-2 and sg-1", groups)
+	}
+}
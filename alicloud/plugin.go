@@ -14,7 +14,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		DefaultTransform: transform.FromCamel().NullIfZero(),
 		DefaultGetConfig: &plugin.GetConfig{
 			IgnoreConfig: &plugin.IgnoreConfig{
-				ShouldIgnoreErrorFunc: isNotFoundError([]string{"EntityNotExist", "ResourceNotFound", "NotFound"}),
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"*EntityNotExist*", "*NotFound*"}),
 			},
 		},
 		// Default ignore config for the plugin
@@ -33,9 +33,21 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		TableMap: map[string]*plugin.Table{
 			"alicloud_account":                                    tableAlicloudAccount(ctx),
 			"alicloud_action_trail":                               tableAlicloudActionTrail(ctx),
+			"alicloud_action_trail_event":                         tableAlicloudActionTrailEvent(ctx),
 			"alicloud_alidns_domain":                              tableAlicloudAlidnsDomain(ctx),
+			"alicloud_arn":                                        tableAlicloudArn(ctx),
+			"alicloud_bss_account_balance":                        tableAlicloudBssAccountBalance(ctx),
+			"alicloud_bss_bill_overview":                          tableAlicloudBssBillOverview(ctx),
+			"alicloud_bss_instance_bill":                          tableAlicloudBssInstanceBill(ctx),
+			"alicloud_bss_resource_package":                       tableAlicloudBssResourcePackage(ctx),
 			"alicloud_cas_certificate":                            tableAlicloudUserCertificate(ctx),
 			"alicloud_cms_monitor_host":                           tableAlicloudCmsMonitorHost(ctx),
+			"alicloud_config_aggregator":                          tableAlicloudConfigAggregator(ctx),
+			"alicloud_config_resource_history":                    tableAlicloudConfigResourceHistory(ctx),
+			"alicloud_config_rule":                                tableAlicloudConfigRule(ctx),
+			"alicloud_config_rule_compliance":                     tableAlicloudConfigRuleCompliance(ctx),
+			"alicloud_connection_diagnostic":                      tableAlicloudConnectionDiagnostic(ctx),
+			"alicloud_control_policy":                             tableAlicloudControlPolicy(ctx),
 			"alicloud_cs_kubernetes_cluster":                      tableAlicloudCsKubernetesCluster(ctx),
 			"alicloud_cs_kubernetes_cluster_node":                 tableAlicloudCsKubernetesClusterNode(ctx),
 			"alicloud_ecs_auto_provisioning_group":                tableAlicloudEcsAutoProvisioningGroup(ctx),
@@ -56,19 +68,28 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"alicloud_ecs_network_interface":                      tableAlicloudEcsEni(ctx),
 			"alicloud_ecs_region":                                 tableAlicloudEcsRegion(ctx),
 			"alicloud_ecs_security_group":                         tableAlicloudEcsSecurityGroup(ctx),
+			"alicloud_ecs_security_group_rule":                    tableAlicloudEcsSecurityGroupRule(ctx),
 			"alicloud_ecs_snapshot":                               tableAlicloudEcsSnapshot(ctx),
 			"alicloud_ecs_zone":                                   tableAlicloudEcsZone(ctx),
 			"alicloud_kms_key":                                    tableAlicloudKmsKey(ctx),
 			"alicloud_kms_secret":                                 tableAlicloudKmsSecret(ctx),
+			"alicloud_network_path":                               tableAlicloudNetworkPath(ctx),
 			"alicloud_oss_bucket":                                 tableAlicloudOssBucket(ctx),
+			"alicloud_oss_object":                                 tableAlicloudOssObject(ctx),
+			"alicloud_public_endpoint":                            tableAlicloudPublicEndpoint(ctx),
+			"alicloud_query_error":                                tableAlicloudQueryError(ctx),
 			"alicloud_ram_access_key":                             tableAlicloudRAMAccessKey(ctx),
 			"alicloud_ram_credential_report":                      tableAlicloudRAMCredentialReport(ctx),
 			"alicloud_ram_group":                                  tableAlicloudRAMGroup(ctx),
+			"alicloud_ram_login_profile":                          tableAlicloudRAMLoginProfile(ctx),
+			"alicloud_ram_oidc_provider":                          tableAlicloudRAMOIDCProvider(ctx),
 			"alicloud_ram_password_policy":                        tableAlicloudRamPasswordPolicy(ctx),
 			"alicloud_ram_policy":                                 tableAlicloudRamPolicy(ctx),
 			"alicloud_ram_role":                                   tableAlicloudRAMRole(ctx),
+			"alicloud_ram_saml_provider":                          tableAlicloudRAMSAMLProvider(ctx),
 			"alicloud_ram_security_preference":                    tableAlicloudRAMSecurityPreference(ctx),
 			"alicloud_ram_user":                                   tableAlicloudRAMUser(ctx),
+			"alicloud_ram_user_sso_settings":                      tableAlicloudRAMUserSsoSettings(ctx),
 			"alicloud_rds_backup":                                 tableAlicloudRdsBackup(ctx),
 			"alicloud_rds_database":                               tableAlicloudRdsDatabase(ctx),
 			"alicloud_rds_instance":                               tableAlicloudRdsInstance(ctx),
@@ -77,7 +98,15 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"alicloud_rds_instance_metric_cpu_utilization":        tableAlicloudRdsInstanceMetricCpuUtilization(ctx),
 			"alicloud_rds_instance_metric_cpu_utilization_daily":  tableAlicloudRdsInstanceMetricCpuUtilizationDaily(ctx),
 			"alicloud_rds_instance_metric_cpu_utilization_hourly": tableAlicloudRdsInstanceMetricCpuUtilizationHourly(ctx),
+			"alicloud_resource":                                   tableAlicloudResource(ctx),
+			"alicloud_resource_center_resource":                   tableAlicloudResourceCenterResource(ctx),
+			"alicloud_resource_directory":                         tableAlicloudResourceDirectory(ctx),
+			"alicloud_resource_directory_account":                 tableAlicloudResourceDirectoryAccount(ctx),
+			"alicloud_resource_directory_folder":                  tableAlicloudResourceDirectoryFolder(ctx),
+			"alicloud_resource_manager_resource_group":            tableAlicloudResourceManagerResourceGroup(ctx),
+			"alicloud_security_center_alert":                      tableAlicloudSecurityCenterAlert(ctx),
 			"alicloud_security_center_asset":                      tableAlicloudSecurityCenterAsset(ctx),
+			"alicloud_security_center_baseline_check":             tableAlicloudSecurityCenterBaselineCheck(ctx),
 			"alicloud_security_center_field_statistics":           tableAlicloudSecurityCenterFieldStatistics(ctx),
 			"alicloud_security_center_vulnerability":              tableAlicloudSecurityCenterVulnerability(ctx),
 			"alicloud_security_center_version":                    tableAlicloudSecurityCenterVersion(ctx),
@@ -85,6 +114,10 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"alicloud_sls_alert":                                  tableAlicloudSLSAlert(ctx),
 			"alicloud_log_store":                                  tableAlicloudLogStore(ctx),
 			"alicloud_log_project":                                 tableAlicloudLogProject(ctx),
+			"alicloud_tag_key":                                    tableAlicloudTagKey(ctx),
+			"alicloud_tag_policy":                                 tableAlicloudTagPolicy(ctx),
+			"alicloud_tag_policy_compliance":                      tableAlicloudTagPolicyCompliance(ctx),
+			"alicloud_tag_resource":                               tableAlicloudTagResource(ctx),
 			"alicloud_vpc":                                        tableAlicloudVpc(ctx),
 			"alicloud_vpc_dhcp_options_set":                       tableAlicloudVpcDhcpOptionsSet(ctx),
 			"alicloud_vpc_eip":                                    tableAlicloudVpcEip(ctx),
@@ -101,5 +134,6 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"alicloud_vpc_vswitch":                                tableAlicloudVpcVSwitch(ctx),
 		},
 	}
+	setHydrateNames(p)
 	return p
 }
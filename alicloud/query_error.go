@@ -0,0 +1,168 @@
+package alicloud
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// queryError is an error ignored because of the "ignore_error_codes" config
+// argument. Ignored errors make a table return fewer rows, so they are
+// recorded to tell an incomplete result from an empty one.
+type queryError struct {
+	Connection   string
+	TableName    string
+	Region       string
+	Hydrate      string
+	ErrorCode    string
+	StatusCode   int
+	ErrorMessage string
+	Count        int
+	FirstSeen    time.Time
+	LastSeen     time.Time
+}
+
+// queryErrorKey identifies the errors counted together
+type queryErrorKey struct {
+	connection string
+	table      string
+	region     string
+	hydrate    string
+	code       string
+}
+
+// queryErrors are the errors ignored since the plugin started
+var queryErrors = struct {
+	sync.Mutex
+	errors map[queryErrorKey]*queryError
+}{errors: map[queryErrorKey]*queryError{}}
+
+// recordQueryError records an error ignored by a hydrate function
+func recordQueryError(ctx context.Context, d *plugin.QueryData, apiErr alicloudError, err error) {
+	var connection, table string
+	if d.Connection != nil {
+		connection = d.Connection.Name
+	}
+	if d.Table != nil {
+		table = d.Table.Name
+	}
+	hydrate, _ := ctx.Value(contextKeyHydrate).(string)
+
+	key := queryErrorKey{
+		connection: connection,
+		table:      table,
+		region:     getErrorRegion(d),
+		hydrate:    hydrate,
+		code:       apiErr.Code,
+	}
+	now := time.Now()
+
+	queryErrors.Lock()
+	defer queryErrors.Unlock()
+
+	entry, ok := queryErrors.errors[key]
+	if !ok {
+		entry = &queryError{
+			Connection: key.connection,
+			TableName:  key.table,
+			Region:     key.region,
+			Hydrate:    key.hydrate,
+			ErrorCode:  key.code,
+			FirstSeen:  now,
+		}
+		queryErrors.errors[key] = entry
+	}
+	entry.StatusCode = apiErr.Status
+	entry.ErrorMessage = err.Error()
+	entry.Count++
+	entry.LastSeen = now
+}
+
+// getQueryErrors returns a copy of the errors ignored by the queries of a
+// connection, sorted by table, region, hydrate function and error code
+func getQueryErrors(connection string) []queryError {
+	queryErrors.Lock()
+	defer queryErrors.Unlock()
+
+	var result []queryError
+	for _, entry := range queryErrors.errors {
+		if entry.Connection == connection {
+			result = append(result, *entry)
+		}
+	}
+	slices.SortFunc(result, func(a, b queryError) int {
+		return cmp.Or(
+			cmp.Compare(a.TableName, b.TableName),
+			cmp.Compare(a.Region, b.Region),
+			cmp.Compare(a.Hydrate, b.Hydrate),
+			cmp.Compare(a.ErrorCode, b.ErrorCode),
+		)
+	})
+	return result
+}
+
+type contextKey string
+
+// contextKeyHydrate is the name of the hydrate function whose error is checked
+// by an ignore config
+const contextKeyHydrate contextKey = "hydrate"
+
+// setHydrateNames gives every hydrate function of the tables an ignore config
+// which passes the name of the function to the predicates of this plugin, so
+// that the errors they ignore can be recorded per hydrate function. The
+// predicate of each function is resolved in the same order as the SDK does.
+func setHydrateNames(p *plugin.Plugin) {
+	var defaultGetIgnoreConfig *plugin.IgnoreConfig
+	if p.DefaultGetConfig != nil {
+		defaultGetIgnoreConfig = p.DefaultGetConfig.IgnoreConfig
+	}
+
+	for _, table := range p.TableMap {
+		configured := map[string]bool{}
+
+		if table.List != nil {
+			table.List.IgnoreConfig = namedIgnoreConfig(table.List.Hydrate, table.List.IgnoreConfig, table.DefaultIgnoreConfig, p.DefaultIgnoreConfig)
+		}
+		if table.Get != nil {
+			table.Get.IgnoreConfig = namedIgnoreConfig(table.Get.Hydrate, table.Get.IgnoreConfig, defaultGetIgnoreConfig, table.DefaultIgnoreConfig, p.DefaultIgnoreConfig)
+			// The SDK uses the get config for the get function in columns
+			configured[helpers.GetFunctionName(table.Get.Hydrate)] = true
+		}
+		for i := range table.HydrateConfig {
+			hydrateConfig := &table.HydrateConfig[i]
+			hydrateConfig.IgnoreConfig = namedIgnoreConfig(hydrateConfig.Func, hydrateConfig.IgnoreConfig, table.DefaultIgnoreConfig, p.DefaultIgnoreConfig)
+			configured[helpers.GetFunctionName(hydrateConfig.Func)] = true
+		}
+		for _, column := range table.Columns {
+			if column.Hydrate == nil || configured[helpers.GetFunctionName(column.Hydrate)] {
+				continue
+			}
+			table.HydrateConfig = append(table.HydrateConfig, plugin.HydrateConfig{
+				Func:         column.Hydrate,
+				IgnoreConfig: namedIgnoreConfig(column.Hydrate, nil, table.DefaultIgnoreConfig, p.DefaultIgnoreConfig),
+			})
+			configured[helpers.GetFunctionName(column.Hydrate)] = true
+		}
+	}
+}
+
+// namedIgnoreConfig returns an ignore config calling the first predicate of
+// the configs with the name of the hydrate function in the context
+func namedIgnoreConfig(hydrate plugin.HydrateFunc, configs ...*plugin.IgnoreConfig) *plugin.IgnoreConfig {
+	predicate := getErrorPredicate(configs...)
+	if predicate == nil {
+		return configs[0]
+	}
+
+	name := helpers.GetFunctionName(hydrate)
+	return &plugin.IgnoreConfig{
+		ShouldIgnoreErrorFunc: func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
+			return predicate(context.WithValue(ctx, contextKeyHydrate, name), d, h, err)
+		},
+	}
+}
@@ -0,0 +1,400 @@
+package alicloud
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// nonResourceTables are the tables with ARNs which do not list resources of
+// the account, such as regions, or which list findings about the resources
+// of other tables
+var nonResourceTables = []string{
+	"alicloud_ecs_region",
+	"alicloud_ecs_zone",
+	"alicloud_resource",
+	"alicloud_security_center_alert",
+	"alicloud_security_center_asset",
+	"alicloud_security_center_baseline_check",
+	"alicloud_security_center_version",
+	"alicloud_security_center_vulnerability",
+	"alicloud_tag_policy_compliance",
+	"alicloud_tag_resource",
+}
+
+// resourceTableConcurrency is the number of resource tables and regions
+// listed at the same time
+const resourceTableConcurrency = 10
+
+// resourceTableFilters are the column values of the rows of a resource table
+// which are owned by the account, such as custom images. They are passed to
+// the list function of the table as quals, and checked on every row.
+var resourceTableFilters = map[string]map[string]string{
+	"alicloud_ecs_image":  {"image_owner_alias": "self"},
+	"alicloud_ram_policy": {"policy_type": "Custom"},
+}
+
+// getResourceTables returns the tables of the plugin which list resources of
+// the account: the tables with an ARN which can be listed without quals
+func getResourceTables(p *plugin.Plugin) []*plugin.Table {
+	var tables []*plugin.Table
+	for _, table := range p.TableMap {
+		if isResourceTable(table) {
+			tables = append(tables, table)
+		}
+	}
+	slices.SortFunc(tables, func(a, b *plugin.Table) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return tables
+}
+
+func isResourceTable(table *plugin.Table) bool {
+	if table.List == nil || slices.Contains(nonResourceTables, table.Name) {
+		return false
+	}
+	for _, keyColumn := range table.List.KeyColumns {
+		if keyColumn.Require != plugin.Optional {
+			return false
+		}
+	}
+	return getTableColumn(table, "arn") != nil || getTableColumn(table, "akas") != nil
+}
+
+// getResourceType returns the resource type of the rows of a resource table,
+// which is the name of the table without the plugin prefix
+func getResourceType(table *plugin.Table) string {
+	return strings.TrimPrefix(table.Name, "alicloud_")
+}
+
+func getTableColumn(table *plugin.Table, name string) *plugin.Column {
+	for _, column := range table.Columns {
+		if column.Name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+// resourceTableItem is a list item of a resource table. Only the list item
+// and the hydrate functions of the ARN and tags of the item are used to
+// describe the resource.
+type resourceTableItem interface {
+	resourceTable() *plugin.Table
+	listItem() interface{}
+	arn(ctx context.Context) (string, error)
+	tags(ctx context.Context) (interface{}, error)
+	columnValue(ctx context.Context, name string) (interface{}, error)
+}
+
+// resourceTableHydrateColumns are the columns of a resource table whose
+// hydrate functions are called for a list item. The other columns are only
+// evaluated if they are transforms of the list item.
+var resourceTableHydrateColumns = []string{"arn", "akas", "tags"}
+
+// listResourceTables lists the items of resource tables in each of their
+// matrix items like the SDK does, optionally only in the matrix items of a
+// region. The tables are listed concurrently,
+// so streamItem must be safe for concurrent use. The first error is returned
+// once all the tables have been listed.
+func listResourceTables(ctx context.Context, d *plugin.QueryData, tables []*plugin.Table, region string, streamItem func(context.Context, resourceTableItem) error) error {
+	type resourceTableQuery struct {
+		table      *plugin.Table
+		matrixItem map[string]interface{}
+	}
+	var queries []resourceTableQuery
+	for _, table := range tables {
+		if table.GetMatrixItemFunc == nil {
+			queries = append(queries, resourceTableQuery{table: table, matrixItem: map[string]interface{}{}})
+			continue
+		}
+		for _, matrixItem := range table.GetMatrixItemFunc(ctx, d) {
+			if region != "" && matrixItem[matrixKeyRegion] != region {
+				continue
+			}
+			queries = append(queries, resourceTableQuery{table: table, matrixItem: matrixItem})
+		}
+	}
+
+	var (
+		wg       sync.WaitGroup
+		lock     sync.Mutex
+		queryErr error
+	)
+	semaphore := make(chan struct{}, resourceTableConcurrency)
+	for _, query := range queries {
+		// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			if err := listResourceTable(ctx, d, query.table, query.matrixItem, streamItem); err != nil {
+				plugin.Logger(ctx).Error("listResourceTables", "table", query.table.Name, "matrix_item", query.matrixItem, "query_error", err)
+				lock.Lock()
+				defer lock.Unlock()
+				if queryErr == nil {
+					queryErr = err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return queryErr
+}
+
+// listResourceTable lists the items of a resource table in a matrix item.
+// The list function of the table is called with a copy of the query data,
+// which keeps the connection, rate limiters and row limit of the query, with
+// the quals replaced by the matrix item and the filters of the table. Errors
+// are ignored according to the ignore configs of the table.
+//
+// The SDK has no API to create the query data of another table, so the copy
+// relies on the fields of plugin.QueryData in steampipe-plugin-sdk v5.13.1,
+// which TestQueryDataFields checks when the SDK is updated.
+func listResourceTable(ctx context.Context, d *plugin.QueryData, table *plugin.Table, matrixItem map[string]interface{}, streamItem func(context.Context, resourceTableItem) error) error {
+	ctx = context.WithValue(ctx, context_key.MatrixItem, matrixItem)
+
+	tableData := *d
+	tableData.Table = table
+	tableData.EqualsQuals = plugin.KeyColumnEqualsQualMap{}
+	tableData.Quals = plugin.KeyColumnQualMap{}
+	for column, value := range matrixItem {
+		setResourceTableQual(&tableData, column, value)
+	}
+	for column, value := range resourceTableFilters[table.Name] {
+		setResourceTableQual(&tableData, column, value)
+	}
+
+	var streamErr error
+	rowData := tableData
+	rowData.StreamListItem = func(ctx context.Context, items ...interface{}) {
+		for _, item := range items {
+			if streamErr != nil {
+				return
+			}
+			row := newResourceTableRow(&rowData, table, matrixItem, item)
+			if matched, err := row.matchFilters(ctx); err != nil || !matched {
+				streamErr = err
+				continue
+			}
+			streamErr = streamItem(ctx, row)
+		}
+	}
+	rowData.StreamLeafListItem = rowData.StreamListItem
+
+	list := func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) error {
+		_, err := table.List.Hydrate(ctx, d, h)
+		if err != nil && !shouldIgnoreResourceTableError(ctx, d, err, table.List.IgnoreConfig, table.DefaultIgnoreConfig, table.Plugin.DefaultIgnoreConfig) {
+			return err
+		}
+		return streamErr
+	}
+
+	if table.List.ParentHydrate == nil {
+		return list(ctx, &rowData, &plugin.HydrateData{})
+	}
+
+	// The items of parent-child tables are listed for each item of the parent
+	parentData := tableData
+	parentData.StreamListItem = func(ctx context.Context, items ...interface{}) {
+		for _, item := range items {
+			if streamErr != nil {
+				return
+			}
+			if err := list(ctx, &rowData, &plugin.HydrateData{Item: item}); err != nil {
+				streamErr = err
+			}
+		}
+	}
+	_, err := table.List.ParentHydrate(ctx, &parentData, &plugin.HydrateData{})
+	if err != nil && !shouldIgnoreResourceTableError(ctx, &parentData, err, table.List.IgnoreConfig, table.DefaultIgnoreConfig, table.Plugin.DefaultIgnoreConfig) {
+		return err
+	}
+	return streamErr
+}
+
+func setResourceTableQual(d *plugin.QueryData, column string, value interface{}) {
+	qualValue := proto.NewQualValue(value)
+	d.EqualsQuals[column] = qualValue
+	d.Quals[column] = &plugin.KeyColumnQuals{
+		Name:  column,
+		Quals: []*quals.Qual{{Column: column, Operator: quals.QualOperatorEqual, Value: qualValue}},
+	}
+}
+
+// shouldIgnoreResourceTableError returns true if an error is ignored by the
+// first ignore config with a predicate, as the SDK does
+func shouldIgnoreResourceTableError(ctx context.Context, d *plugin.QueryData, err error, configs ...*plugin.IgnoreConfig) bool {
+	predicate := getErrorPredicate(configs...)
+	return predicate != nil && predicate(ctx, d, nil, err)
+}
+
+// getErrorPredicate returns the predicate of the first ignore config which has one
+func getErrorPredicate(configs ...*plugin.IgnoreConfig) plugin.ErrorPredicateWithContext {
+	for _, config := range configs {
+		if config != nil && config.ShouldIgnoreErrorFunc != nil {
+			return config.ShouldIgnoreErrorFunc
+		}
+	}
+	return nil
+}
+
+// resourceTableRow is the resourceTableItem of a list item of a table
+type resourceTableRow struct {
+	d          *plugin.QueryData
+	table      *plugin.Table
+	matrixItem map[string]interface{}
+	item       interface{}
+	// results are the results of the hydrate functions called for the item,
+	// the arn and akas columns of a table usually share a hydrate function
+	results map[string]interface{}
+}
+
+func newResourceTableRow(d *plugin.QueryData, table *plugin.Table, matrixItem map[string]interface{}, item interface{}) *resourceTableRow {
+	return &resourceTableRow{
+		d:          d,
+		table:      table,
+		matrixItem: matrixItem,
+		item:       item,
+		results:    map[string]interface{}{},
+	}
+}
+
+func (r *resourceTableRow) resourceTable() *plugin.Table {
+	return r.table
+}
+
+func (r *resourceTableRow) listItem() interface{} {
+	return r.item
+}
+
+// arn returns the ARN of the item, from the arn column of the table or else
+// the first of its akas
+func (r *resourceTableRow) arn(ctx context.Context) (string, error) {
+	arn, err := r.columnValue(ctx, "arn")
+	if err != nil {
+		return "", err
+	}
+	if arn := types.SafeString(arn); arn != "" {
+		return arn, nil
+	}
+
+	akas, err := r.columnValue(ctx, "akas")
+	if err != nil {
+		return "", err
+	}
+	if akaList, ok := akas.([]string); ok && len(akaList) > 0 {
+		return akaList[0], nil
+	}
+	return "", nil
+}
+
+func (r *resourceTableRow) tags(ctx context.Context) (interface{}, error) {
+	return r.columnValue(ctx, "tags")
+}
+
+// matchFilters returns true if the item is owned by the account
+func (r *resourceTableRow) matchFilters(ctx context.Context) (bool, error) {
+	for column, value := range resourceTableFilters[r.table.Name] {
+		columnValue, err := r.columnValue(ctx, column)
+		if err != nil {
+			return false, err
+		}
+		if columnValue != value {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// columnValue returns the value of a column for the item, or nil if the table
+// has no such column or if it needs a hydrate function other than those of
+// resourceTableHydrateColumns
+func (r *resourceTableRow) columnValue(ctx context.Context, name string) (interface{}, error) {
+	column := getTableColumn(r.table, name)
+	if column == nil {
+		return nil, nil
+	}
+
+	hydrateItem := r.item
+	if column.Hydrate != nil {
+		if !slices.Contains(resourceTableHydrateColumns, name) {
+			return nil, nil
+		}
+		var err error
+		hydrateItem, err = r.hydrate(ctx, column.Hydrate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if helpers.IsNil(hydrateItem) {
+		return nil, nil
+	}
+
+	columnTransforms := column.Transform
+	if columnTransforms == nil {
+		columnTransforms = r.table.DefaultTransform
+	}
+	if columnTransforms == nil {
+		columnTransforms = r.table.Plugin.DefaultTransform
+	}
+	if columnTransforms == nil {
+		columnTransforms = transform.FromField(column.Name)
+	}
+	// The transforms read the matrix item from the context
+	ctx = context.WithValue(ctx, context_key.MatrixItem, r.matrixItem)
+	return columnTransforms.Execute(ctx, &transform.TransformData{
+		HydrateItem:    hydrateItem,
+		HydrateResults: r.results,
+		ColumnName:     column.Name,
+		KeyColumnQuals: r.d.Quals.ToQualMap(),
+	})
+}
+
+// hydrate calls a hydrate function once for the item. The hydrate functions of
+// the columns of resourceTableHydrateColumns only use the list item.
+func (r *resourceTableRow) hydrate(ctx context.Context, hydrate plugin.HydrateFunc) (interface{}, error) {
+	name := helpers.GetFunctionName(hydrate)
+	if result, ok := r.results[name]; ok {
+		return result, nil
+	}
+
+	var ignoreConfig *plugin.IgnoreConfig
+	if r.table.Get != nil && helpers.GetFunctionName(r.table.Get.Hydrate) == name {
+		ignoreConfig = r.table.Get.IgnoreConfig
+	}
+	for _, hydrateConfig := range r.table.HydrateConfig {
+		if helpers.GetFunctionName(hydrateConfig.Func) == name {
+			ignoreConfig = hydrateConfig.IgnoreConfig
+		}
+	}
+
+	ctx = context.WithValue(ctx, context_key.MatrixItem, r.matrixItem)
+	result, err := hydrate(ctx, r.d, &plugin.HydrateData{Item: r.item})
+	if err != nil {
+		if !shouldIgnoreResourceTableError(ctx, r.d, err, ignoreConfig, r.table.DefaultIgnoreConfig, r.table.Plugin.DefaultIgnoreConfig) {
+			return nil, err
+		}
+		result = nil
+	}
+	r.results[name] = result
+	return result, nil
+}
@@ -0,0 +1,302 @@
+package alicloud
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/actiontrail"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cas"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/config"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/aliyun/alibabacloud-oss-go-sdk-v2/oss"
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+)
+
+// resourceTableTestItems are list items of the resource tables, of the types
+// streamed by their list functions
+var resourceTableTestItems = map[string]interface{}{
+	"alicloud_account":                         &ram.GetAccountAliasResponse{},
+	"alicloud_action_trail":                    actiontrail.Trail{},
+	"alicloud_alidns_domain":                   alidns.DomainInDescribeDomains{},
+	"alicloud_cas_certificate":                 cas.CertificateOrderListItem{},
+	"alicloud_cms_monitor_host":                cms.Host{},
+	"alicloud_config_rule":                     config.ConfigRule{ConfigRuleArn: "acs:config::123:rule/cr-1"},
+	"alicloud_cs_kubernetes_cluster":           map[string]interface{}{},
+	"alicloud_cs_kubernetes_cluster_node":      &NodeInfo{},
+	"alicloud_ecs_auto_provisioning_group":     ecs.AutoProvisioningGroup{},
+	"alicloud_ecs_autoscaling_group":           ess.ScalingGroup{},
+	"alicloud_ecs_disk":                        ecs.Disk{},
+	"alicloud_ecs_image":                       ecs.Image{ImageOwnerAlias: "self"},
+	"alicloud_ecs_instance":                    ecs.Instance{},
+	"alicloud_ecs_key_pair":                    ecs.KeyPair{},
+	"alicloud_ecs_launch_template":             ecs.LaunchTemplateSet{},
+	"alicloud_ecs_network_interface":           ecs.NetworkInterfaceSet{},
+	"alicloud_ecs_security_group":              ecs.SecurityGroup{},
+	"alicloud_ecs_snapshot":                    ecs.Snapshot{},
+	"alicloud_kms_key":                         kms.KeyMetadata{Arn: "acs:kms:cn-hangzhou:123:key/key-1"},
+	"alicloud_kms_secret":                      &kms.DescribeSecretResponse{},
+	"alicloud_log_project":                     &sls.LogProject{},
+	"alicloud_log_store":                       logstoreItem{},
+	"alicloud_oss_bucket":                      oss.BucketProperties{},
+	"alicloud_ram_access_key":                  accessKeyRow{},
+	"alicloud_ram_group":                       groupInfo{},
+	"alicloud_ram_oidc_provider":               ramOIDCProvider{Arn: "acs:ram::123:oidc-provider/oidc-1"},
+	"alicloud_ram_policy":                      ram.Policy{PolicyType: "Custom"},
+	"alicloud_ram_role":                        roleInfo{Arn: "acs:ram::123:role/role-1"},
+	"alicloud_ram_saml_provider":               ramSAMLProvider{Arn: "acs:ram::123:saml-provider/saml-1"},
+	"alicloud_ram_user":                        userInfo{},
+	"alicloud_rds_instance":                    rds.DBInstance{},
+	"alicloud_resource_manager_resource_group": resourcemanager.ResourceGroup{},
+	"alicloud_slb_load_balancer":               slb.LoadBalancer{},
+	"alicloud_sls_alert":                       slsAlertItem{},
+	"alicloud_vpc":                             vpc.Vpc{},
+	"alicloud_vpc_dhcp_options_set":            vpc.DhcpOptionsSet{},
+	"alicloud_vpc_eip":                         vpc.EipAddress{},
+	"alicloud_vpc_flow_log":                    vpc.FlowLog{},
+	"alicloud_vpc_nat_gateway":                 vpc.NatGateway{},
+	"alicloud_vpc_network_acl":                 vpc.NetworkAcl{},
+	"alicloud_vpc_route_entry":                 vpc.RouteEntry{},
+	"alicloud_vpc_route_table":                 vpc.RouterTableListType{},
+	"alicloud_vpc_ssl_vpn_client_cert":         vpnSslClientCertInfo{},
+	"alicloud_vpc_ssl_vpn_server":              vpc.SslVpnServer{},
+	"alicloud_vpc_vpn_connection":              vpc.VpnConnection{},
+	"alicloud_vpc_vpn_customer_gateway":        vpc.CustomerGateway{},
+	"alicloud_vpc_vpn_gateway":                 vpc.VpnGateway{},
+	"alicloud_vpc_vswitch":                     vpc.VSwitch{},
+}
+
+// newTestContext returns a context with the logger used by the hydrate and
+// transform functions
+func newTestContext() context.Context {
+	return context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+}
+
+// newTestPlugin returns the plugin with its tables linked to it, as done by
+// the SDK when the plugin is served
+func newTestPlugin(ctx context.Context) *plugin.Plugin {
+	p := Plugin(ctx)
+	for _, table := range p.TableMap {
+		table.Plugin = p
+	}
+	return p
+}
+
+func TestGetResourceTables(t *testing.T) {
+	ctx := context.Background()
+	tables := getResourceTables(newTestPlugin(ctx))
+
+	var names []string
+	for _, table := range tables {
+		names = append(names, table.Name)
+	}
+	for _, name := range []string{"alicloud_ecs_instance", "alicloud_oss_bucket", "alicloud_ram_user", "alicloud_vpc_vswitch"} {
+		if !slices.Contains(names, name) {
+			t.Errorf("getResourceTables() does not return %s", name)
+		}
+	}
+	for _, name := range nonResourceTables {
+		if slices.Contains(names, name) {
+			t.Errorf("getResourceTables() returns %s", name)
+		}
+	}
+	for name := range resourceTableTestItems {
+		if !slices.Contains(names, name) {
+			t.Errorf("%s has a test item but is not returned by getResourceTables()", name)
+		}
+	}
+}
+
+func TestGetResourceInfo(t *testing.T) {
+	ctx := newTestContext()
+	matrixItem := map[string]interface{}{matrixKeyRegion: "cn-hangzhou"}
+
+	for _, table := range getResourceTables(newTestPlugin(ctx)) {
+		t.Run(table.Name, func(t *testing.T) {
+			item, ok := resourceTableTestItems[table.Name]
+			if !ok {
+				t.Fatalf("add a list item of %s to resourceTableTestItems", table.Name)
+			}
+
+			// The hydrate functions are called with the list item only
+			arnFromItem := true
+			for _, name := range resourceTableHydrateColumns {
+				column := getTableColumn(table, name)
+				if column == nil || column.Hydrate == nil {
+					continue
+				}
+				if name != "tags" {
+					arnFromItem = false
+				}
+				for _, hydrateConfig := range table.HydrateConfig {
+					if helpers.GetFunctionName(hydrateConfig.Func) == helpers.GetFunctionName(column.Hydrate) && len(hydrateConfig.Depends) > 0 {
+						t.Errorf("the hydrate function of the %s column depends on other hydrate functions", name)
+					}
+				}
+			}
+			for column := range resourceTableFilters[table.Name] {
+				if getTableColumn(table, column).Hydrate != nil {
+					t.Errorf("the filter column %s has a hydrate function", column)
+				}
+			}
+
+			d := &plugin.QueryData{
+				Table:        table,
+				QueryContext: &plugin.QueryContext{Columns: []string{"arn", "tags", "created_time"}},
+			}
+			row := newResourceTableRow(d, table, matrixItem, item)
+			// The hydrate functions call the APIs, they return no result
+			for _, name := range resourceTableHydrateColumns {
+				if column := getTableColumn(table, name); column != nil && column.Hydrate != nil {
+					row.results[helpers.GetFunctionName(column.Hydrate)] = nil
+				}
+			}
+
+			matched, err := row.matchFilters(ctx)
+			if err != nil || !matched {
+				t.Errorf("matchFilters() = %v, %v, want true", matched, err)
+			}
+			resource, err := getResourceInfo(ctx, d, row)
+			if err != nil {
+				t.Fatalf("getResourceInfo() error = %v", err)
+			}
+			if resource.ResourceType != getResourceType(table) {
+				t.Errorf("ResourceType = %q, want %q", resource.ResourceType, getResourceType(table))
+			}
+			if arnFromItem {
+				if _, err := parseArn(resource.Arn); err != nil {
+					t.Errorf("Arn = %q: %v", resource.Arn, err)
+				}
+			}
+		})
+	}
+}
+
+func TestGetResourceInfoMatrixRegion(t *testing.T) {
+	ctx := newTestContext()
+	p := newTestPlugin(ctx)
+	matrixItem := map[string]interface{}{matrixKeyRegion: "cn-hangzhou"}
+
+	tests := []struct {
+		table  string
+		item   interface{}
+		wantId string
+		want   string
+	}{
+		{"alicloud_ecs_network_interface", ecs.NetworkInterfaceSet{NetworkInterfaceId: "eni-1", OwnerId: "123", ZoneId: "cn-hangzhou-k"}, "eni-1", "acs:ecs:cn-hangzhou:123:networkinterface/eni-1"},
+		{"alicloud_vpc_vswitch", vpc.VSwitch{VSwitchId: "vsw-1", OwnerId: 123, ZoneId: "cn-hangzhou-k"}, "vsw-1", "acs:vpc:cn-hangzhou:123:vswitch/vsw-1"},
+	}
+	for _, test := range tests {
+		t.Run(test.table, func(t *testing.T) {
+			table := p.TableMap[test.table]
+			d := &plugin.QueryData{Table: table, QueryContext: &plugin.QueryContext{}}
+			resource, err := getResourceInfo(ctx, d, newResourceTableRow(d, table, matrixItem, test.item))
+			if err != nil {
+				t.Fatalf("getResourceInfo() error = %v", err)
+			}
+			if resource.Arn != test.want || resource.Region != "cn-hangzhou" || resource.AccountId != "123" || resource.ResourceId != test.wantId {
+				t.Errorf("getResourceInfo() = %+v, want the ARN %q", resource, test.want)
+			}
+		})
+	}
+}
+
+func TestResourceTableRowMatchFilters(t *testing.T) {
+	ctx := context.Background()
+	table := newTestPlugin(ctx).TableMap["alicloud_ecs_image"]
+	d := &plugin.QueryData{Table: table}
+
+	tests := []struct {
+		owner string
+		want  bool
+	}{
+		{"self", true},
+		{"system", false},
+		{"marketplace", false},
+	}
+	for _, test := range tests {
+		row := newResourceTableRow(d, table, nil, ecs.Image{ImageOwnerAlias: test.owner})
+		if got, err := row.matchFilters(ctx); err != nil || got != test.want {
+			t.Errorf("matchFilters(%q) = %v, %v, want %v", test.owner, got, err, test.want)
+		}
+	}
+}
+
+// TestQueryDataFields fails when the fields of plugin.QueryData change.
+// listResourceTable copies the query data of the query, including its
+// unexported fields such as the query status and the rate limiters, which
+// are those of steampipe-plugin-sdk v5.13.1. Check that the copy still works
+// before updating this list for a new SDK version.
+func TestQueryDataFields(t *testing.T) {
+	want := []struct {
+		name string
+		typ  string
+	}{
+		{"Table", "*plugin.Table"},
+		{"EqualsQuals", "plugin.KeyColumnEqualsQualMap"},
+		{"Quals", "plugin.KeyColumnQualMap"},
+		{"FetchType", "plugin.fetchType"},
+		{"QueryContext", "*plugin.QueryContext"},
+		{"Connection", "*plugin.Connection"},
+		{"Matrix", "[]map[string]interface {}"},
+		{"ConnectionManager", "*connection.Manager"},
+		{"ConnectionCache", "*connection.ConnectionCache"},
+		{"StreamListItem", "func(context.Context, ...interface {})"},
+		{"StreamLeafListItem", "func(context.Context, ...interface {})"},
+		{"queryStatus", "*plugin.queryStatus"},
+		{"connectionCallId", "string"},
+		{"plugin", "*plugin.Plugin"},
+		{"hydrateCalls", "[]*plugin.hydrateCall"},
+		{"fetchLimiters", "*plugin.fetchCallRateLimiters"},
+		{"columns", "map[string]*plugin.QueryColumn"},
+		{"rowDataChan", "chan *plugin.rowData"},
+		{"errorChan", "chan error"},
+		{"outputChan", "chan *proto.ExecuteResponse"},
+		{"listWg", "*sync.WaitGroup"},
+		{"parentItem", "interface {}"},
+		{"filteredMatrix", "[]map[string]interface {}"},
+		{"filteredMatrixColumns", "[]string"},
+		{"matrixColLookup", "map[string]struct {}"},
+		{"matrixItem", "map[string]interface {}"},
+		{"cacheTtl", "int64"},
+		{"cacheEnabled", "bool"},
+		{"cacheResultKey", "string"},
+		{"cacheColumns", "[]string"},
+		{"cacheRows", "[]*proto.Row"},
+		{"hydrateColumnMap", "map[string][]string"},
+		{"freeMemInterval", "int64"},
+		{"tempDir", "string"},
+		{"reservedColumns", "map[string]struct {}"},
+		{"cancel", "context.CancelFunc"},
+		{"rateLimiterScopeValues", "map[string]string"},
+		{"fetchMetadata", "*plugin.hydrateMetadata"},
+		{"parentHydrateMetadata", "*plugin.hydrateMetadata"},
+		{"listHydrate", "plugin.namedHydrateFunc"},
+		{"childHydrate", "plugin.namedHydrateFunc"},
+	}
+
+	queryDataType := reflect.TypeOf(plugin.QueryData{})
+	if queryDataType.NumField() != len(want) {
+		t.Errorf("plugin.QueryData has %d fields, want %d", queryDataType.NumField(), len(want))
+	}
+	for i := 0; i < min(queryDataType.NumField(), len(want)); i++ {
+		field := queryDataType.Field(i)
+		if field.Name != want[i].name || field.Type.String() != want[i].typ {
+			t.Errorf("plugin.QueryData field %d is %s %s, want %s %s", i, field.Name, field.Type, want[i].name, want[i].typ)
+		}
+	}
+}
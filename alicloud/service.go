@@ -2,8 +2,14 @@ package alicloud
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
@@ -11,346 +17,367 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/actiontrail"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cas"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/config"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcecenter"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/sas"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/tag"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 
 	"github.com/aliyun/alibabacloud-oss-go-sdk-v2/oss"
 	ossCred "github.com/aliyun/alibabacloud-oss-go-sdk-v2/oss/credentials"
 	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"golang.org/x/net/http/httpproxy"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
 
-// AliDNSService returns the service connection for Alicloud DNS service
-func AliDNSService(ctx context.Context, d *plugin.QueryData) (*alidns.Client, error) {
-	region := d.EqualsQualString(matrixKeyRegion)
-
-	if region == "" {
-		return nil, fmt.Errorf("region must be passed AliDNSService")
-	}
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("alidns-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*alidns.Client), nil
-	}
+// serviceClient describes how the client of an Alibaba Cloud service is
+// created. Every service constructor below is a single call to get.
+type serviceClient[T any] struct {
+	// name of the service, used in the cache key
+	name string
+	// newClient is the NewClientWithOptions constructor of the SDK package
+	newClient func(regionId string, config *sdk.Config, credential auth.Credential) (T, error)
+	// region resolves the region of the client when the caller passes none
+	region func(d *plugin.QueryData) string
+	// endpoint pins the client to a fixed endpoint, for services the SDK
+	// ships no endpoint for
+	endpoint string
+	// intlEndpoint is the endpoint used instead of endpoint for the
+	// international site
+	intlEndpoint string
+}
 
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
+// regionalService returns a service whose clients are created per region. The
+// region is passed by the caller or taken from the matrix.
+func regionalService[T any](name string, newClient func(string, *sdk.Config, auth.Credential) (T, error)) serviceClient[T] {
+	return serviceClient[T]{
+		name:      name,
+		newClient: newClient,
+		region: func(d *plugin.QueryData) string {
+			return d.EqualsQualString(matrixKeyRegion)
+		},
 	}
-	cfg := credCfg.(*CredentialConfig)
+}
 
-	// so it was not in cache - create service
-	svc, err := alidns.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
+// globalService returns a service whose client is created in the default
+// region of the connection
+func globalService[T any](name string, newClient func(string, *sdk.Config, auth.Credential) (T, error)) serviceClient[T] {
+	return serviceClient[T]{
+		name:      name,
+		newClient: newClient,
+		region: func(d *plugin.QueryData) string {
+			return GetDefaultRegion(d.Connection)
+		},
 	}
+}
 
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+// withRegion overrides how the region of the client is resolved
+func (s serviceClient[T]) withRegion(region func(d *plugin.QueryData) string) serviceClient[T] {
+	s.region = region
+	return s
+}
 
-	return svc, nil
+// withEndpoint pins the client to a fixed endpoint
+func (s serviceClient[T]) withEndpoint(endpoint string) serviceClient[T] {
+	s.endpoint = endpoint
+	return s
 }
 
-// AutoscalingService returns the service connection for Alicloud Autoscaling service
-func AutoscalingService(ctx context.Context, d *plugin.QueryData) (*ess.Client, error) {
-	region := d.EqualsQualString(matrixKeyRegion)
+// withIntlEndpoint sets the endpoint of the service on the international site
+func (s serviceClient[T]) withIntlEndpoint(endpoint string) serviceClient[T] {
+	s.intlEndpoint = endpoint
+	return s
+}
 
+// get returns the client of the service in the given region, or in the
+// region resolved by the service if region is empty
+func (s serviceClient[T]) get(ctx context.Context, d *plugin.QueryData, region string) (T, error) {
 	if region == "" {
-		return nil, fmt.Errorf("region must be passed AutoscalingService")
+		region = s.region(d)
 	}
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("ess-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*ess.Client), nil
-	}
-
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
-	}
-	cfg := credCfg.(*CredentialConfig)
-
-	// so it was not in cache - create service
-	svc, err := ess.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
-	}
-
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return getCachedServiceClient(d, s.name, region, func() (T, error) {
+		var svc T
 
-	return svc, nil
-}
+		endpointType, err := getEndpointType(d)
+		if err != nil {
+			return svc, err
+		}
 
-// CasService returns the service connection for Alicloud SSL service
-func CasService(ctx context.Context, d *plugin.QueryData, region string) (*cas.Client, error) {
-	if region == "" {
-		return nil, fmt.Errorf("region must be passed CasService")
-	}
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("cas-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*cas.Client), nil
-	}
+		credCfg, err := getCredentialSessionCached(ctx, d, nil)
+		if err != nil {
+			return svc, err
+		}
+		cfg := credCfg.(*CredentialConfig)
 
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
-	}
-	cfg := credCfg.(*CredentialConfig)
+		svc, err = s.newClient(region, cfg.Config, cfg.Creds)
+		if err != nil {
+			return svc, err
+		}
 
-	// so it was not in cache - create service
-	svc, err := cas.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
-	}
+		if client, ok := any(svc).(sdkNetworkClient); ok {
+			setClientNetworkConfig(d, client, cfg.Config)
+		}
 
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+		endpoint := s.endpoint
+		if s.intlEndpoint != "" && getSite(d.Connection) == siteInternational {
+			endpoint = s.intlEndpoint
+		}
 
-	return svc, nil
+		// An endpoint from the connection config wins over everything else.
+		// Otherwise the SDK resolves the endpoint of the region, with the
+		// VPC endpoints of the services for endpoint_type = "vpc".
+		switch {
+		case getCustomEndpoint(d, s.name, region) != "":
+			setClientProperty(svc, "Domain", getCustomEndpoint(d, s.name, region))
+		case endpoint != "" && endpointType == endpointTypeVpc:
+			setClientProperty(svc, "Domain", vpcEndpoint(endpoint))
+		case endpoint != "":
+			setClientProperty(svc, "Domain", endpoint)
+		case endpointType == endpointTypeVpc:
+			setClientProperty(svc, "Network", "vpc")
+		}
+		return svc, nil
+	})
 }
 
-// CmsService returns the service connection for Alicloud CMS service
-func CmsService(ctx context.Context, d *plugin.QueryData) (*cms.Client, error) {
-	region := GetDefaultRegion(d.Connection)
-
-	if region == "" {
-		return nil, fmt.Errorf("region must be passed CmsService")
-	}
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("cms-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*cms.Client), nil
-	}
+// Endpoint types of the endpoint_type connection option
+const (
+	endpointTypePublic = "public"
+	endpointTypeVpc    = "vpc"
+	endpointTypeIntl   = "intl"
+)
 
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
+// getEndpointType returns the endpoint type of the connection, public by default
+func getEndpointType(d *plugin.QueryData) (string, error) {
+	alicloudConfig := GetConfig(d.Connection)
+	if alicloudConfig.EndpointType == nil {
+		return endpointTypePublic, nil
 	}
-	cfg := credCfg.(*CredentialConfig)
 
-	// so it was not in cache - create service
-	svc, err := cms.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
+	switch endpointType := *alicloudConfig.EndpointType; endpointType {
+	case endpointTypePublic, endpointTypeVpc, endpointTypeIntl:
+		return endpointType, nil
+	default:
+		return "", fmt.Errorf("invalid endpoint_type %q, must be one of public, vpc or intl", endpointType)
 	}
-
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
-
-	return svc, nil
 }
 
-// ECSService returns the service connection for Alicloud ECS service
-func ECSService(ctx context.Context, d *plugin.QueryData) (*ecs.Client, error) {
-	region := d.EqualsQualString(matrixKeyRegion)
+// Sites of the site connection option. Accounts of the China site
+// (aliyun.com) and of the international site (alibabacloud.com) use different
+// endpoints for some central services.
+const (
+	siteChina         = "china"
+	siteInternational = "international"
+)
 
-	if region == "" {
-		return nil, fmt.Errorf("region must be passed ECSService")
+// getSite returns the site of the connection. It defaults to the
+// international site for endpoint_type = "intl", and to the China site
+// otherwise.
+func getSite(connection *plugin.Connection) string {
+	alicloudConfig := GetConfig(connection)
+	if alicloudConfig.Site != nil {
+		return *alicloudConfig.Site
 	}
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("ecs-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*ecs.Client), nil
+	if alicloudConfig.EndpointType != nil && *alicloudConfig.EndpointType == endpointTypeIntl {
+		return siteInternational
 	}
+	return siteChina
+}
 
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
-	}
-	cfg := credCfg.(*CredentialConfig)
+// serviceNames are the names of the services in the endpoints connection
+// option, which are also the service tags of the hydrate functions and the
+// services of the "service=" terms of ignore_error_codes
+var serviceNames = []string{
+	"actiontrail",
+	"alidns",
+	"bssopenapi",
+	"cas",
+	"cms",
+	"config",
+	"cs",
+	"ecs",
+	"ess",
+	"ims",
+	"kms",
+	"oss",
+	"ram",
+	"rds",
+	"resourcecenter",
+	"resourcemanager",
+	"sas",
+	"slb",
+	"sls",
+	"sts",
+	"tag",
+	"vpc",
+}
 
-	// so it was not in cache - create service
-	svc, err := ecs.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
+// getCustomEndpoint returns the endpoint set for a service in the endpoints
+// connection option. An entry for "<service>:<region>" wins over an entry for
+// "<service>".
+func getCustomEndpoint(d *plugin.QueryData, service string, region string) string {
+	alicloudConfig := GetConfig(d.Connection)
+	if endpoint, ok := alicloudConfig.Endpoints[service+":"+region]; ok {
+		return endpoint
 	}
-
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
-
-	return svc, nil
+	return alicloudConfig.Endpoints[service]
 }
 
-// ECSRegionService returns the service connection for Alicloud ECS Region service
-func ECSRegionService(ctx context.Context, d *plugin.QueryData, region string) (*ecs.Client, error) {
-	if region == "" {
-		return nil, fmt.Errorf("region must be passed ECSRegionService")
+// getServiceEndpoint returns the endpoint of a client that is not built on
+// the core SDK, given its public and VPC endpoints
+func getServiceEndpoint(d *plugin.QueryData, service string, region string, publicEndpoint string, vpcEndpoint string) (string, error) {
+	endpointType, err := getEndpointType(d)
+	if err != nil {
+		return "", err
 	}
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("ecsregion-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*ecs.Client), nil
+	if endpoint := getCustomEndpoint(d, service, region); endpoint != "" {
+		return endpoint, nil
 	}
-
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
+	if endpointType == endpointTypeVpc {
+		return vpcEndpoint, nil
 	}
-	cfg := credCfg.(*CredentialConfig)
+	return publicEndpoint, nil
+}
 
-	// so it was not in cache - create service
-	svc, err := ecs.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
+// vpcEndpoint returns the VPC endpoint of a central service endpoint, for
+// example resourcecenter-vpc.aliyuncs.com for resourcecenter.aliyuncs.com
+func vpcEndpoint(endpoint string) string {
+	product, domain, found := strings.Cut(endpoint, ".")
+	if !found {
+		return endpoint
 	}
+	return product + "-vpc." + domain
+}
 
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
-
-	return svc, nil
+// sdkNetworkClient is implemented by the sdk.Client embedded in every service
+// client
+type sdkNetworkClient interface {
+	SetTransport(transport http.RoundTripper)
+	SetHttpProxy(httpProxy string)
+	SetHttpsProxy(httpsProxy string)
+	SetNoProxy(noProxy string)
+	SetHTTPSInsecure(isInsecure bool)
 }
 
-// KMSService returns the service connection for Alicloud KMS service
-func KMSService(ctx context.Context, d *plugin.QueryData) (*kms.Client, error) {
-	region := d.EqualsQualString(matrixKeyRegion)
+// setClientNetworkConfig applies the proxy and TLS options of the connection
+// to a service client. The SDK resolves the proxy and the TLS verification on
+// every request and writes them to the transport of the client, so the client
+// gets its own copy of the transport and the options are set on the client
+// too.
+func setClientNetworkConfig(d *plugin.QueryData, client sdkNetworkClient, sdkConfig *sdk.Config) {
+	alicloudConfig := GetConfig(d.Connection)
 
-	if region == "" {
-		return nil, fmt.Errorf("region must be passed KMSService")
+	if sdkConfig.HttpTransport != nil {
+		client.SetTransport(sdkConfig.HttpTransport.Clone())
 	}
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("kms-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*kms.Client), nil
+	if alicloudConfig.HttpProxy != nil {
+		client.SetHttpProxy(*alicloudConfig.HttpProxy)
 	}
-
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
+	if alicloudConfig.HttpsProxy != nil {
+		client.SetHttpsProxy(*alicloudConfig.HttpsProxy)
 	}
-	cfg := credCfg.(*CredentialConfig)
-
-	// so it was not in cache - create service
-	svc, err := kms.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
+	if alicloudConfig.NoProxy != nil {
+		client.SetNoProxy(*alicloudConfig.NoProxy)
+	}
+	if alicloudConfig.InsecureSkipVerify != nil {
+		client.SetHTTPSInsecure(*alicloudConfig.InsecureSkipVerify)
 	}
-
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
-
-	return svc, nil
 }
 
-// RAMService returns the service connection for Alicloud RAM service
-func RAMService(ctx context.Context, d *plugin.QueryData) (*ram.Client, error) {
-	region := GetDefaultRegion(d.Connection)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("ram-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*ram.Client), nil
+// getHTTPTransport returns the HTTP transport built from the proxy and TLS
+// options of the connection, or nil if none of them is set. Proxies that are
+// not set in the connection config are read from the environment.
+func getHTTPTransport(d *plugin.QueryData) (*http.Transport, error) {
+	alicloudConfig := GetConfig(d.Connection)
+	if alicloudConfig.HttpProxy == nil && alicloudConfig.HttpsProxy == nil && alicloudConfig.NoProxy == nil &&
+		alicloudConfig.CaBundleFile == nil && alicloudConfig.InsecureSkipVerify == nil {
+		return nil, nil
 	}
 
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
+	proxyConfig := httpproxy.FromEnvironment()
+	if alicloudConfig.HttpProxy != nil {
+		proxyConfig.HTTPProxy = *alicloudConfig.HttpProxy
 	}
-	cfg := credCfg.(*CredentialConfig)
-
-	// so it was not in cache - create service
-	svc, err := ram.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
+	if alicloudConfig.HttpsProxy != nil {
+		proxyConfig.HTTPSProxy = *alicloudConfig.HttpsProxy
 	}
-
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
-
-	return svc, nil
-}
-
-// SLBService returns the service connection for Alicloud Server Load Balancer service
-func SLBService(ctx context.Context, d *plugin.QueryData) (*slb.Client, error) {
-	region := GetDefaultRegion(d.Connection)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("slb-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*slb.Client), nil
+	if alicloudConfig.NoProxy != nil {
+		proxyConfig.NoProxy = *alicloudConfig.NoProxy
 	}
+	proxyFunc := proxyConfig.ProxyFunc()
 
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
 	}
-	cfg := credCfg.(*CredentialConfig)
+	transport.TLSClientConfig = &tls.Config{}
 
-	// so it was not in cache - create service
-	svc, err := slb.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
+	if alicloudConfig.CaBundleFile != nil {
+		pemCerts, err := os.ReadFile(*alicloudConfig.CaBundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_bundle_file: %v", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pemCerts) {
+			return nil, fmt.Errorf("no PEM certificates found in ca_bundle_file %s", *alicloudConfig.CaBundleFile)
+		}
+		transport.TLSClientConfig.RootCAs = rootCAs
+	}
+	if alicloudConfig.InsecureSkipVerify != nil {
+		transport.TLSClientConfig.InsecureSkipVerify = *alicloudConfig.InsecureSkipVerify
 	}
 
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
-
-	return svc, nil
+	return transport, nil
 }
 
-// StsService returns the service connection for Alicloud STS service
-func StsService(ctx context.Context, d *plugin.QueryData) (*sts.Client, error) {
-	region := GetDefaultRegion(d.Connection)
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("sts-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*sts.Client), nil
-	}
-
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
+// withNetworkConfig applies the proxy and TLS options of the connection to an
+// SDK config
+func withNetworkConfig(d *plugin.QueryData, sdkConfig *sdk.Config) (*sdk.Config, error) {
+	transport, err := getHTTPTransport(d)
 	if err != nil {
 		return nil, err
 	}
-	cfg := credCfg.(*CredentialConfig)
-
-	// so it was not in cache - create service
-	svc, err := sts.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
+	if transport != nil {
+		sdkConfig = sdkConfig.WithHttpTransport(transport)
 	}
-
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
-
-	return svc, nil
+	return sdkConfig, nil
 }
 
-// VpcService returns the service connection for Alicloud VPC service
-func VpcService(ctx context.Context, d *plugin.QueryData) (*vpc.Client, error) {
-	region := d.EqualsQualString(matrixKeyRegion)
-
+// getCachedServiceClient returns the client of a service in a region from the
+// connection cache, creating it on first use. The connection cache is scoped
+// to the connection, and so to its account and credentials.
+func getCachedServiceClient[T any](d *plugin.QueryData, name string, region string, create func() (T, error)) (T, error) {
 	if region == "" {
-		return nil, fmt.Errorf("region must be passed VpcService")
+		var svc T
+		return svc, fmt.Errorf("region must be passed to the %s service client", name)
 	}
+
 	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("vpc-%s", region)
+	serviceCacheKey := fmt.Sprintf("%s-%s", name, region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*vpc.Client), nil
-	}
-
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
+		return cachedData.(T), nil
 	}
-	cfg := credCfg.(*CredentialConfig)
 
 	// so it was not in cache - create service
-	svc, err := vpc.NewClientWithOptions(region, cfg.Config, cfg.Creds)
+	svc, err := create()
 	if err != nil {
-		return nil, err
+		return svc, err
 	}
 
 	// cache the service connection
@@ -359,217 +386,248 @@ func VpcService(ctx context.Context, d *plugin.QueryData) (*vpc.Client, error) {
 	return svc, nil
 }
 
-// OssService returns the service connection for Alicloud OSS service
-func OssService(ctx context.Context, d *plugin.QueryData, region string) (*oss.Client, error) {
-	// Validate the region parameter before proceeding
-	if region == "" {
-		return nil, fmt.Errorf("region must be provided to initialize the OSS service")
+// setClientProperty sets a field of the sdk.Client embedded in every service
+// client, the same way the SDK packages do it
+func setClientProperty(client interface{}, name string, value interface{}) {
+	field := reflect.ValueOf(client).Elem().FieldByName(name)
+	if field.IsValid() && field.CanSet() {
+		field.Set(reflect.ValueOf(value))
 	}
+}
 
-	// Check if the OSS client is already cached to avoid redundant initialization
-	serviceCacheKey := fmt.Sprintf("oss-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*oss.Client), nil
-	}
+// AliDNSService returns the service connection for Alicloud DNS service
+func AliDNSService(ctx context.Context, d *plugin.QueryData) (*alidns.Client, error) {
+	return regionalService("alidns", alidns.NewClientWithOptions).get(ctx, d, "")
+}
 
-	// Construct the OSS endpoint for the given region
-	endpoint := "oss-" + region + ".aliyuncs.com"
+// AutoscalingService returns the service connection for Alicloud Autoscaling service
+func AutoscalingService(ctx context.Context, d *plugin.QueryData) (*ess.Client, error) {
+	return regionalService("ess", ess.NewClientWithOptions).get(ctx, d, "")
+}
 
-	// Initialize OSS client configuration
-	ossCfg := oss.NewConfig()
-	ossCfg.WithEndpoint(endpoint)
-	ossCfg.WithRegion(region)
-	ossCfg.WithProxyFromEnvironment(true)
+// BssService returns the service connection for Alicloud BSS OpenAPI, the
+// billing service of the account
+func BssService(ctx context.Context, d *plugin.QueryData) (*bssopenapi.Client, error) {
+	return globalService("bssopenapi", bssopenapi.NewClientWithOptions).
+		withEndpoint("business.aliyuncs.com").
+		withIntlEndpoint("business.ap-southeast-1.aliyuncs.com").
+		get(ctx, d, "")
+}
 
-	// Retrieve cached credentials for authentication
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve cached credentials: %v", err)
-	}
+// CasService returns the service connection for Alicloud SSL service
+func CasService(ctx context.Context, d *plugin.QueryData, region string) (*cas.Client, error) {
+	return regionalService("cas", cas.NewClientWithOptions).get(ctx, d, region)
+}
 
-	cfg := credCfg.(*CredentialConfig)
+// CmsService returns the service connection for Alicloud CMS service
+func CmsService(ctx context.Context, d *plugin.QueryData) (*cms.Client, error) {
+	return globalService("cms", cms.NewClientWithOptions).get(ctx, d, "")
+}
 
-	// Convert the credential configuration to an OSS-compatible provider
-	credentialProvider, err := auth.ToCredentialsProvider(cfg.Creds)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert credentials to a provider: %v", err)
-	}
+// ECSService returns the service connection for Alicloud ECS service
+func ECSService(ctx context.Context, d *plugin.QueryData) (*ecs.Client, error) {
+	return regionalService("ecs", ecs.NewClientWithOptions).get(ctx, d, "")
+}
 
-	// Retrieve credentials from the provider
-	profileCred, err := credentialProvider.GetCredentials()
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve credentials from the provider: %v", err)
-	}
+// ECSRegionService returns the service connection for Alicloud ECS service in the given region
+func ECSRegionService(ctx context.Context, d *plugin.QueryData, region string) (*ecs.Client, error) {
+	return regionalService("ecs", ecs.NewClientWithOptions).get(ctx, d, region)
+}
 
-	ossCfg.CredentialsProvider = ossCred.NewStaticCredentialsProvider(profileCred.AccessKeyId, profileCred.AccessKeySecret, profileCred.SecurityToken)
+// KMSService returns the service connection for Alicloud KMS service
+func KMSService(ctx context.Context, d *plugin.QueryData) (*kms.Client, error) {
+	return regionalService("kms", kms.NewClientWithOptions).get(ctx, d, "")
+}
 
-	// Initialize and return the OSS client
-	svc := oss.NewClient(ossCfg)
+// RAMService returns the service connection for Alicloud RAM service
+func RAMService(ctx context.Context, d *plugin.QueryData) (*ram.Client, error) {
+	return globalService("ram", ram.NewClientWithOptions).get(ctx, d, "")
+}
 
-	// Cache the service connection to optimize future requests
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+// IMSService returns the service connection for Alicloud IMS service, which
+// serves the identity APIs of RAM such as the credential report. The SDK has no
+// IMS package, so a RAM client sends common requests to the IMS endpoint,
+// which is the same on both sites.
+func IMSService(ctx context.Context, d *plugin.QueryData) (*ram.Client, error) {
+	return globalService("ims", ram.NewClientWithOptions).withEndpoint("ims.aliyuncs.com").get(ctx, d, "")
+}
 
-	return svc, nil
+// SLBService returns the service connection for Alicloud Server Load Balancer service
+func SLBService(ctx context.Context, d *plugin.QueryData) (*slb.Client, error) {
+	return globalService("slb", slb.NewClientWithOptions).get(ctx, d, "")
 }
 
-// ActionTrailService returns the service connection for Alicloud ActionTrail service
-func ActionTrailService(ctx context.Context, d *plugin.QueryData) (*actiontrail.Client, error) {
-	region := d.EqualsQualString(matrixKeyRegion)
+// StsService returns the service connection for Alicloud STS service
+func StsService(ctx context.Context, d *plugin.QueryData) (*sts.Client, error) {
+	return globalService("sts", sts.NewClientWithOptions).
+		withIntlEndpoint("sts.ap-southeast-1.aliyuncs.com").
+		get(ctx, d, "")
+}
 
-	if region == "" {
-		return nil, fmt.Errorf("region must be passed ActionTrailService")
-	}
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("actiontrail-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*actiontrail.Client), nil
-	}
+// VpcService returns the service connection for Alicloud VPC service
+func VpcService(ctx context.Context, d *plugin.QueryData) (*vpc.Client, error) {
+	return regionalService("vpc", vpc.NewClientWithOptions).get(ctx, d, "")
+}
 
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
-	}
-	cfg := credCfg.(*CredentialConfig)
+// OssService returns the service connection for Alicloud OSS service
+func OssService(ctx context.Context, d *plugin.QueryData, region string) (*oss.Client, error) {
+	return getCachedServiceClient(d, "oss", region, func() (*oss.Client, error) {
+		// Construct the OSS endpoint for the given region
+		endpoint, err := getOssEndpoint(d, region)
+		if err != nil {
+			return nil, err
+		}
 
-	// so it was not in cache - create service
-	svc, err := actiontrail.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
-	}
+		// Initialize OSS client configuration
+		ossCfg := oss.NewConfig()
+		ossCfg.WithEndpoint(endpoint)
+		ossCfg.WithRegion(region)
+		ossCfg.WithProxyFromEnvironment(true)
 
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+		// Apply the proxy and TLS options of the connection
+		transport, err := getHTTPTransport(d)
+		if err != nil {
+			return nil, err
+		}
+		if transport != nil {
+			ossCfg.WithHttpClient(&http.Client{Transport: transport})
+		}
 
-	return svc, nil
+		// Retrieve the credentials of the connection for authentication
+		profileCred, err := getProviderCredentials(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		ossCfg.CredentialsProvider = ossCred.NewStaticCredentialsProvider(profileCred.AccessKeyId, profileCred.AccessKeySecret, profileCred.SecurityToken)
+
+		// Initialize and return the OSS client
+		return oss.NewClient(ossCfg), nil
+	})
+}
+
+// ActionTrailService returns the service connection for Alicloud ActionTrail service
+func ActionTrailService(ctx context.Context, d *plugin.QueryData) (*actiontrail.Client, error) {
+	return regionalService("actiontrail", actiontrail.NewClientWithOptions).get(ctx, d, "")
 }
 
 // ContainerService returns the service connection for Alicloud Container service
 func ContainerService(ctx context.Context, d *plugin.QueryData) (*cs.Client, error) {
-	region := GetDefaultRegion(d.Connection)
-
-	if region == "" {
-		return nil, fmt.Errorf("region must be passed ContainerService")
-	}
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("cs-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*cs.Client), nil
-	}
-
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
-	}
-	cfg := credCfg.(*CredentialConfig)
-
-	// so it was not in cache - create service
-	svc, err := cs.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
-	}
-
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
-
-	return svc, nil
+	return globalService("cs", cs.NewClientWithOptions).get(ctx, d, "")
 }
 
 // SecurityCenterService returns the service connection for Alicloud Security Center service
 func SecurityCenterService(ctx context.Context, d *plugin.QueryData, region string) (*sas.Client, error) {
-	if region == "" {
-		return nil, fmt.Errorf("region must be passed SecurityCenterService")
-	}
+	return regionalService("sas", sas.NewClientWithOptions).get(ctx, d, region)
+}
 
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("sas-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*sas.Client), nil
-	}
+// RDSService returns the service connection for Alicloud RDS service
+func RDSService(ctx context.Context, d *plugin.QueryData, region string) (*rds.Client, error) {
+	return regionalService("rds", rds.NewClientWithOptions).get(ctx, d, region)
+}
 
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
-	}
-	cfg := credCfg.(*CredentialConfig)
+// SLSService returns the client interface for Alicloud Log Service (SLS)
+func SLSService(ctx context.Context, d *plugin.QueryData, region string) (sls.ClientInterface, error) {
+	return getCachedServiceClient(d, "sls", region, func() (sls.ClientInterface, error) {
+		endpoint, err := getSlsEndpoint(d, region)
+		if err != nil {
+			return nil, err
+		}
 
-	// so it was not in cache - create service
-	svc, err := sas.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
-	}
+		// Retrieve the credentials of the connection for authentication
+		profileCred, err := getProviderCredentials(ctx, d)
+		if err != nil {
+			return nil, err
+		}
 
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+		staticProvider := sls.NewStaticCredentialsProvider(profileCred.AccessKeyId, profileCred.AccessKeySecret, profileCred.SecurityToken)
+		client := sls.CreateNormalInterfaceV2(endpoint, staticProvider)
 
-	return svc, nil
+		// Apply the proxy and TLS options of the connection
+		transport, err := getHTTPTransport(d)
+		if err != nil {
+			return nil, err
+		}
+		if transport != nil {
+			client.SetHTTPClient(&http.Client{Transport: transport})
+		}
+		return client, nil
+	})
 }
 
-// RDSService returns the service connection for Alicloud RDS service
-func RDSService(ctx context.Context, d *plugin.QueryData, region string) (*rds.Client, error) {
-	if region == "" {
-		return nil, fmt.Errorf("region must be passed RDSService")
-	}
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("rds-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*rds.Client), nil
-	}
+// getOssEndpoint returns the endpoint of the OSS service in a region
+func getOssEndpoint(d *plugin.QueryData, region string) (string, error) {
+	return getServiceEndpoint(d, "oss", region, "oss-"+region+".aliyuncs.com", "oss-"+region+"-internal.aliyuncs.com")
+}
 
-	credCfg, err := getCredentialSessionCached(ctx, d, nil)
-	if err != nil {
-		return nil, err
-	}
-	cfg := credCfg.(*CredentialConfig)
+// getSlsEndpoint returns the endpoint of the Log Service in a region
+func getSlsEndpoint(d *plugin.QueryData, region string) (string, error) {
+	return getServiceEndpoint(d, "sls", region, region+".log.aliyuncs.com", region+"-intranet.log.aliyuncs.com")
+}
 
-	// so it was not in cache - create service
-	svc, err := rds.NewClientWithOptions(region, cfg.Config, cfg.Creds)
-	if err != nil {
-		return nil, err
-	}
+// ResourceManagerService returns the service connection for Alicloud Resource Manager service
+func ResourceManagerService(ctx context.Context, d *plugin.QueryData) (*resourcemanager.Client, error) {
+	return globalService("resourcemanager", resourcemanager.NewClientWithOptions).get(ctx, d, "")
+}
 
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+// ResourceCenterService returns the service connection for Alicloud Resource Center service
+func ResourceCenterService(ctx context.Context, d *plugin.QueryData) (*resourcecenter.Client, error) {
+	// Resource Center is a central service and the SDK ships no endpoint for it
+	return globalService("resourcecenter", resourcecenter.NewClientWithOptions).
+		withEndpoint("resourcecenter.aliyuncs.com").
+		withIntlEndpoint("resourcecenter-intl.aliyuncs.com").
+		get(ctx, d, "")
+}
 
-	return svc, nil
+// TagService returns the service connection for Alicloud Tag service
+func TagService(ctx context.Context, d *plugin.QueryData) (*tag.Client, error) {
+	return regionalService("tag", tag.NewClientWithOptions).get(ctx, d, "")
 }
 
-// SLSService returns the client interface for Alicloud Log Service (SLS)
-func SLSService(ctx context.Context, d *plugin.QueryData, region string) (sls.ClientInterface, error) {
-	if region == "" {
-		return nil, fmt.Errorf("region must be provided to initialize the SLS service")
-	}
+// TagPolicyService returns the service connection for Alicloud Tag service in the
+// default region, used for tag policies which are not regional
+func TagPolicyService(ctx context.Context, d *plugin.QueryData) (*tag.Client, error) {
+	return globalService("tag", tag.NewClientWithOptions).get(ctx, d, "")
+}
 
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("sls-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(sls.ClientInterface), nil
+// ConfigService returns the service connection for Alicloud Cloud Config service
+func ConfigService(ctx context.Context, d *plugin.QueryData) (*config.Client, error) {
+	return globalService("config", config.NewClientWithOptions).withRegion(configServiceRegion).get(ctx, d, "")
+}
+
+// configServiceRegion returns the region of the Cloud Config service. It is
+// only served from cn-shanghai, and from ap-southeast-1 for the international
+// site.
+func configServiceRegion(d *plugin.QueryData) string {
+	if getSite(d.Connection) == siteInternational {
+		return "ap-southeast-1"
 	}
+	if GetDefaultRegion(d.Connection) == "ap-southeast-1" {
+		return "ap-southeast-1"
+	}
+	return "cn-shanghai"
+}
 
-	// Retrieve cached credentials for authentication
+// getProviderCredentials returns the access key, secret and token of the
+// connection, for the clients that are not built on the core SDK
+func getProviderCredentials(ctx context.Context, d *plugin.QueryData) (*credentials.Credentials, error) {
 	credCfg, err := getCredentialSessionCached(ctx, d, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve cached credentials: %v", err)
 	}
 	cfg := credCfg.(*CredentialConfig)
 
-	// Convert to a provider and extract AK/SK/token
+	// Convert the credential configuration to a provider
 	credentialProvider, err := auth.ToCredentialsProvider(cfg.Creds)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert credentials to a provider: %v", err)
 	}
+
+	// Retrieve credentials from the provider
 	profileCred, err := credentialProvider.GetCredentials()
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve credentials from the provider: %v", err)
 	}
-
-	staticProvider := sls.NewStaticCredentialsProvider(profileCred.AccessKeyId, profileCred.AccessKeySecret, profileCred.SecurityToken)
-	endpoint := region + ".log.aliyuncs.com"
-	client := sls.CreateNormalInterfaceV2(endpoint, staticProvider)
-
-	// cache the service connection
-	d.ConnectionManager.Cache.Set(serviceCacheKey, client)
-
-	return client, nil
+	return profileCred, nil
 }
 
 // GetDefaultRegion returns the default region used
@@ -585,12 +643,9 @@ func GetDefaultRegion(connection *plugin.Connection) string {
 	}
 
 	if len(regions) > 0 {
-		// Set the first region in regions list to be default region
+		// Set the first region in regions list to be default region. Invalid
+		// regions are reported by validateConfig.
 		region = regions[0]
-		// check if it is a valid region
-		if len(getInvalidRegions([]string{region})) > 0 {
-			panic("\n\nConnection config have invalid region: " + region + ". Edit your connection configuration file and then restart Steampipe")
-		}
 		return region
 	}
 
@@ -606,6 +661,9 @@ func GetDefaultRegion(connection *plugin.Connection) string {
 
 	if region == "" {
 		region = "cn-hangzhou"
+		if getSite(connection) == siteInternational {
+			region = "ap-southeast-1"
+		}
 	}
 
 	return region
@@ -655,7 +713,7 @@ func getEnv(_ context.Context, d *plugin.QueryData) (secretKey string, accessKey
 		if accessKey, ok = os.LookupEnv("ALIBABACLOUD_ACCESS_KEY_ID"); !ok {
 			if accessKey, ok = os.LookupEnv("ALICLOUD_ACCESS_KEY_ID"); !ok {
 				if accessKey, ok = os.LookupEnv("ALICLOUD_ACCESS_KEY"); !ok {
-					panic("\n'access_key' or 'profile' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe.")
+					return "", "", fmt.Errorf("'access_key' or 'profile' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
 				}
 			}
 		}
@@ -668,7 +726,7 @@ func getEnv(_ context.Context, d *plugin.QueryData) (secretKey string, accessKey
 		if secretKey, ok = os.LookupEnv("ALIBABACLOUD_ACCESS_KEY_SECRET"); !ok {
 			if secretKey, ok = os.LookupEnv("ALICLOUD_ACCESS_KEY_SECRET"); !ok {
 				if secretKey, ok = os.LookupEnv("ALICLOUD_SECRET_KEY"); !ok {
-					panic("\n'secret_key' or 'profile' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe.")
+					return "", "", fmt.Errorf("'secret_key' or 'profile' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
 				}
 			}
 		}
@@ -712,6 +770,10 @@ func getCredentialConfigByProfile(profile string, d *plugin.QueryData) (*Credent
 	if config.Timeout != nil {
 		defaultConfig = defaultConfig.WithTimeout(time.Duration(*config.Timeout) * time.Second)
 	}
+	defaultConfig, err := withNetworkConfig(d, defaultConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	// We will get a nil value if the specified profile is not available
 	// Or
@@ -729,7 +791,11 @@ func getCredentialSessionUncached(ctx context.Context, d *plugin.QueryData, h *p
 
 	var connectionCfg *CredentialConfig
 
-	config := GetConfig(d.Connection)
+	config, err := getValidConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
 	defaultRegion := GetDefaultRegion(d.Connection)
 	defaultConfig := sdk.NewConfig() // initialize with default config
 
@@ -742,6 +808,10 @@ func getCredentialSessionUncached(ctx context.Context, d *plugin.QueryData, h *p
 	if config.Timeout != nil {
 		defaultConfig = defaultConfig.WithTimeout(time.Duration(*config.Timeout) * time.Second)
 	}
+	defaultConfig, err = withNetworkConfig(d, defaultConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	// Profile based client
 	if config.Profile != nil {
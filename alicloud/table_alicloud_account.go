@@ -94,5 +94,5 @@ func getAccountAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	return []string{"arn:acs:::" + accountID}, nil
+	return []string{buildArn("ram", "", accountID, "", "root")}, nil
 }
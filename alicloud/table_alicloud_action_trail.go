@@ -216,7 +216,7 @@ func getActionTrailAka(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:actiontrail:" + data.HomeRegion + ":" + accountID + ":actiontrail/" + data.Name}
+	akas := []string{buildArn("actiontrail", data.HomeRegion, accountID, "actiontrail", data.Name)}
 
 	return akas, nil
 }
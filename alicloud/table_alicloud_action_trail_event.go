@@ -0,0 +1,255 @@
+package alicloud
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/actiontrail"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudActionTrailEvent(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_action_trail_event",
+		Description: "Alicloud Action Trail Event",
+		List: &plugin.ListConfig{
+			Hydrate: listActionTrailEvents,
+			Tags:    map[string]string{"service": "actiontrail", "action": "LookupEvents"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "event_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+				{Name: "event_name", Require: plugin.Optional},
+				{Name: "event_rw", Require: plugin.Optional},
+				{Name: "user_name", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "resource_name", Require: plugin.Optional},
+				{Name: "access_key_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "event_id",
+				Description: "The ID of the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("eventId"),
+			},
+			{
+				Name:        "event_name",
+				Description: "The name of the event, usually the name of the API operation that was called.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("eventName"),
+			},
+			{
+				Name:        "event_time",
+				Description: "The time when the event occurred.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("eventTime"),
+			},
+			{
+				Name:        "event_rw",
+				Description: "The read/write type of the event. Possible values are: Read and Write.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("eventRW"),
+			},
+			{
+				Name:        "event_type",
+				Description: "The type of the event. Possible values are: ApiCall, ConsoleOperation, ConsoleSignin, ConsoleSignout, AliyunServiceEvent and PasswordReset.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("eventType"),
+			},
+			{
+				Name:        "event_source",
+				Description: "The source of the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("eventSource"),
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the Alibaba Cloud service that generated the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("serviceName"),
+			},
+			{
+				Name:        "user_name",
+				Description: "The name of the identity that performed the operation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("userIdentity.userName"),
+			},
+			{
+				Name:        "user_type",
+				Description: "The type of the identity that performed the operation, such as root-account, ram-user or assumed-role.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("userIdentity.type"),
+			},
+			{
+				Name:        "principal_id",
+				Description: "The ID of the identity that performed the operation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("userIdentity.principalId"),
+			},
+			{
+				Name:        "access_key_id",
+				Description: "The AccessKey ID that was used to perform the operation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("userIdentity.accessKeyId"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resources affected by the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("resourceType"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The names of the resources affected by the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("resourceName"),
+			},
+			{
+				Name:        "source_ip_address",
+				Description: "The IP address from which the operation was performed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("sourceIpAddress"),
+			},
+			{
+				Name:        "user_agent",
+				Description: "The user agent of the client that performed the operation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("userAgent"),
+			},
+			{
+				Name:        "request_id",
+				Description: "The ID of the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("requestId"),
+			},
+			{
+				Name:        "error_code",
+				Description: "The error code returned if the operation failed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("errorCode"),
+			},
+			{
+				Name:        "error_message",
+				Description: "The error message returned if the operation failed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("errorMessage"),
+			},
+			{
+				Name:        "event",
+				Description: "The full event record.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("eventId"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromMatrixItem(matrixKeyRegion),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listActionTrailEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := ActionTrailService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_action_trail_event.listActionTrailEvents", "connection_error", err)
+		return nil, err
+	}
+
+	request := actiontrail.CreateLookupEventsRequest()
+	request.Scheme = "https"
+	request.MaxResults = "50"
+
+	// If the requested number of items is less than the paging max limit
+	// update limit to the requested no of results.
+	limit := d.QueryContext.Limit
+	if limit != nil && *limit < 50 {
+		request.MaxResults = strconv.FormatInt(*limit, 10)
+	}
+
+	if d.Quals["event_time"] != nil {
+		for _, q := range d.Quals["event_time"].Quals {
+			eventTime := q.Value.GetTimestampValue().AsTime().UTC().Format(time.RFC3339)
+			switch q.Operator {
+			case ">", ">=":
+				request.StartTime = eventTime
+			case "<", "<=":
+				request.EndTime = eventTime
+			case "=":
+				request.StartTime = eventTime
+				request.EndTime = eventTime
+			}
+		}
+	}
+
+	lookupAttributes := map[string]string{
+		"event_name":    "EventName",
+		"event_rw":      "EventRW",
+		"user_name":     "UserName",
+		"resource_type": "ResourceType",
+		"resource_name": "ResourceName",
+		"access_key_id": "EventAccessKeyId",
+	}
+	var attributes []actiontrail.LookupEventsLookupAttribute
+	for column, key := range lookupAttributes {
+		if value := d.EqualsQualString(column); value != "" {
+			attributes = append(attributes, actiontrail.LookupEventsLookupAttribute{Key: key, Value: value})
+		}
+	}
+	if len(attributes) > 0 {
+		request.LookupAttribute = &attributes
+	}
+
+	pageLeft := true
+	for pageLeft {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.LookupEvents(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_action_trail_event.listActionTrailEvents", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, event := range response.Events {
+			d.StreamListItem(ctx, event)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.NextToken != "" {
+			request.NextToken = response.NextToken
+		} else {
+			pageLeft = false
+		}
+	}
+
+	return nil, nil
+}
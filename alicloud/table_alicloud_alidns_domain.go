@@ -310,7 +310,7 @@ func domainToAka(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 		domainName = item.DomainName
 	}
 
-	aka := []string{"acs:alidns:" + region + ":" + accountID + ":domain/" + domainName}
+	aka := []string{buildArn("alidns", region, accountID, "domain", domainName)}
 	return aka, nil
 }
 
@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type arnInfo struct {
+	Arn          string
+	Valid        bool
+	Error        string
+	Service      string
+	Region       string
+	AccountId    string
+	ResourceType string
+	ResourceId   string
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudArn(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_arn",
+		Description: "Parses an Alibaba Cloud Resource Name (ARN) into its service, region, account, resource type and resource ID.",
+		List: &plugin.ListConfig{
+			Hydrate: listArns,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "arn", Require: plugin.Required},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Alibaba Cloud Resource Name (ARN) to parse.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "valid",
+				Description: "True if the ARN is of the form acs:<service>:<region>:<account>:<resource type>/<resource id>.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "error",
+				Description: "The reason why the ARN is not valid.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Error").NullIfZero(),
+			},
+			{
+				Name:        "service",
+				Description: "The service of the resource, such as ecs, vpc or ram.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Service"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource, such as instance or user. OSS resources are of type bucket or object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceType"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceId"),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arn"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: "The region of the resource. Empty for the resources of global services, such as RAM.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Region"),
+			},
+			{
+				Name:        "account_id",
+				Description: "The ID of the account that owns the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listArns(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if _, err := getValidConfig(d); err != nil {
+		return nil, err
+	}
+
+	arn := d.EqualsQualString("arn")
+
+	parsed, err := parseArn(arn)
+	if err != nil {
+		d.StreamListItem(ctx, arnInfo{Arn: arn, Error: err.Error()})
+		return nil, nil
+	}
+
+	d.StreamListItem(ctx, arnInfo{
+		Arn:          arn,
+		Valid:        true,
+		Service:      parsed.Service,
+		Region:       parsed.Region,
+		AccountId:    parsed.AccountId,
+		ResourceType: parsed.ResourceType,
+		ResourceId:   parsed.ResourceId,
+	})
+	return nil, nil
+}
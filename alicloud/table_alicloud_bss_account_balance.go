@@ -0,0 +1,94 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudBssAccountBalance(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_bss_account_balance",
+		Description: "The balance of the account.",
+		List: &plugin.ListConfig{
+			Hydrate: listBssAccountBalances,
+			Tags:    map[string]string{"service": "bssopenapi", "action": "QueryAccountBalance"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "available_amount",
+				Description: "The available balance of the account, including its credit.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("AvailableAmount").Transform(bssAmountToDouble),
+			},
+			{
+				Name:        "available_cash_amount",
+				Description: "The available cash balance of the account.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("AvailableCashAmount").Transform(bssAmountToDouble),
+			},
+			{
+				Name:        "credit_amount",
+				Description: "The credit line of the account.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("CreditAmount").Transform(bssAmountToDouble),
+			},
+			{
+				Name:        "mybank_credit_amount",
+				Description: "The credit line of the account granted by MYbank.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("MybankCreditAmount").Transform(bssAmountToDouble),
+			},
+			{
+				Name:        "currency",
+				Description: "The currency of the amounts, such as CNY or USD.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBssAccountBalances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := BssService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_bss_account_balance.listBssAccountBalances", "connection_error", err)
+		return nil, err
+	}
+
+	request := bssopenapi.CreateQueryAccountBalanceRequest()
+	request.Scheme = "https"
+
+	d.WaitForListRateLimit(ctx)
+	response, err := client.QueryAccountBalance(request)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_bss_account_balance.listBssAccountBalances", "query_error", err, "request", request)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, response.Data)
+
+	return nil, nil
+}
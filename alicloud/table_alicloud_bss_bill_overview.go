@@ -0,0 +1,246 @@
+package alicloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// bssBillingCycleFormat is the format of the billing cycles of the billing
+// APIs, which are months
+const bssBillingCycleFormat = "2006-01"
+
+type bssBillOverviewItem struct {
+	BillingCycle string
+	Item         bssopenapi.Item
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudBssBillOverview(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_bss_bill_overview",
+		Description: "The bills of the account for a month, summarized by product.",
+		List: &plugin.ListConfig{
+			Hydrate: listBssBillOverviews,
+			Tags:    map[string]string{"service": "bssopenapi", "action": "QueryBillOverview"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "billing_cycle", Require: plugin.Optional},
+				{Name: "product_code", Require: plugin.Optional},
+				{Name: "product_type", Require: plugin.Optional},
+				{Name: "subscription_type", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "billing_cycle",
+				Description: "The month of the bill, in the YYYY-MM format. Defaults to the current month.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_code",
+				Description: "The code of the product, such as ecs or rds.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ProductCode"),
+			},
+			{
+				Name:        "product_name",
+				Description: "The name of the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ProductName"),
+			},
+			{
+				Name:        "product_type",
+				Description: "The type of the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ProductType"),
+			},
+			{
+				Name:        "product_detail",
+				Description: "The details of the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ProductDetail"),
+			},
+			{
+				Name:        "subscription_type",
+				Description: "The billing method of the product. Possible values are: Subscription, PayAsYouGo.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.SubscriptionType"),
+			},
+			{
+				Name:        "item",
+				Description: "The type of the bill. Possible values are: SubscriptionOrder, PayAsYouGoBill, Refund, Adjustment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.Item"),
+			},
+			{
+				Name:        "commodity_code",
+				Description: "The code of the service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.CommodityCode"),
+			},
+			{
+				Name:        "pip_code",
+				Description: "The code of the product in the billing system.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.PipCode"),
+			},
+			{
+				Name:        "biz_type",
+				Description: "The business type of the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.BizType"),
+			},
+			{
+				Name:        "pretax_gross_amount",
+				Description: "The amount before discounts.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.PretaxGrossAmount"),
+			},
+			{
+				Name:        "invoice_discount",
+				Description: "The discount amount.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.InvoiceDiscount"),
+			},
+			{
+				Name:        "deducted_by_coupons",
+				Description: "The amount deducted by coupons.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.DeductedByCoupons"),
+			},
+			{
+				Name:        "deducted_by_cash_coupons",
+				Description: "The amount deducted by vouchers.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.DeductedByCashCoupons"),
+			},
+			{
+				Name:        "deducted_by_prepaid_card",
+				Description: "The amount deducted by prepaid cards.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.DeductedByPrepaidCard"),
+			},
+			{
+				Name:        "deducted_by_resource_package",
+				Description: "The usage deducted by resource packages.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.DeductedByResourcePackage"),
+			},
+			{
+				Name:        "pretax_amount",
+				Description: "The amount after discounts and deductions.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.PretaxAmount"),
+			},
+			{
+				Name:        "payment_amount",
+				Description: "The amount paid in cash.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.PaymentAmount"),
+			},
+			{
+				Name:        "outstanding_amount",
+				Description: "The unpaid amount.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.OutstandingAmount"),
+			},
+			{
+				Name:        "adjust_amount",
+				Description: "The amount of the adjustments of the bill.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.AdjustAmount"),
+			},
+			{
+				Name:        "cash_amount",
+				Description: "The amount paid from the balance of the account.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.CashAmount"),
+			},
+			{
+				Name:        "currency",
+				Description: "The currency of the amounts, such as CNY or USD.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.Currency"),
+			},
+			{
+				Name:        "bill_account_id",
+				Description: "The ID of the account to which the bill belongs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.BillAccountID"),
+			},
+			{
+				Name:        "bill_account_name",
+				Description: "The name of the account to which the bill belongs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.BillAccountName"),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ProductName"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBssBillOverviews(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := BssService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_bss_bill_overview.listBssBillOverviews", "connection_error", err)
+		return nil, err
+	}
+
+	request := bssopenapi.CreateQueryBillOverviewRequest()
+	request.Scheme = "https"
+	request.BillingCycle = d.EqualsQualString("billing_cycle")
+	if request.BillingCycle == "" {
+		request.BillingCycle = time.Now().Format(bssBillingCycleFormat)
+	}
+	request.ProductCode = d.EqualsQualString("product_code")
+	request.ProductType = d.EqualsQualString("product_type")
+	request.SubscriptionType = d.EqualsQualString("subscription_type")
+
+	d.WaitForListRateLimit(ctx)
+	response, err := client.QueryBillOverview(request)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_bss_bill_overview.listBssBillOverviews", "query_error", err, "request", request)
+		return nil, err
+	}
+
+	for _, item := range response.Data.Items.Item {
+		d.StreamListItem(ctx, bssBillOverviewItem{BillingCycle: request.BillingCycle, Item: item})
+		// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
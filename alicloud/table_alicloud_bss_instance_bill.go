@@ -0,0 +1,400 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// bssBillingDateFormat is the format of the billing dates of the daily bills
+const bssBillingDateFormat = "2006-01-02"
+
+type bssInstanceBillItem struct {
+	BillingCycle string
+	Item         bssopenapi.Item
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudBssInstanceBill(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_bss_instance_bill",
+		Description: "The daily bills of the account, by instance.",
+		List: &plugin.ListConfig{
+			Hydrate: listBssInstanceBills,
+			Tags:    map[string]string{"service": "bssopenapi", "action": "QueryInstanceBill"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "billing_cycle", Require: plugin.Required},
+				{Name: "billing_date", Require: plugin.Optional},
+				{Name: "product_code", Require: plugin.Optional},
+				{Name: "product_type", Require: plugin.Optional},
+				{Name: "subscription_type", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "instance_id",
+				Description: "The ID of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.InstanceID"),
+			},
+			{
+				Name:        "billing_cycle",
+				Description: "The month of the bill, in the YYYY-MM format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_date",
+				Description: "The day of the bill, in the YYYY-MM-DD format.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.BillingDate"),
+			},
+			{
+				Name:        "product_code",
+				Description: "The code of the product, such as ecs or rds.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ProductCode"),
+			},
+			{
+				Name:        "product_name",
+				Description: "The name of the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ProductName"),
+			},
+			{
+				Name:        "product_type",
+				Description: "The type of the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ProductType"),
+			},
+			{
+				Name:        "product_detail",
+				Description: "The details of the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ProductDetail"),
+			},
+			{
+				Name:        "subscription_type",
+				Description: "The billing method of the instance. Possible values are: Subscription, PayAsYouGo.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.SubscriptionType"),
+			},
+			{
+				Name:        "item",
+				Description: "The type of the bill. Possible values are: SubscriptionOrder, PayAsYouGoBill, Refund, Adjustment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.Item"),
+			},
+			{
+				Name:        "billing_type",
+				Description: "The billing type of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.BillingType"),
+			},
+			{
+				Name:        "commodity_code",
+				Description: "The code of the service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.CommodityCode"),
+			},
+			{
+				Name:        "pip_code",
+				Description: "The code of the product in the billing system.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.PipCode"),
+			},
+			{
+				Name:        "instance_spec",
+				Description: "The specification of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.InstanceSpec"),
+			},
+			{
+				Name:        "instance_config",
+				Description: "The configuration of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.InstanceConfig"),
+			},
+			{
+				Name:        "internet_ip",
+				Description: "The public IP address of the instance.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Item.InternetIP").NullIfZero(),
+			},
+			{
+				Name:        "intranet_ip",
+				Description: "The private IP address of the instance.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Item.IntranetIP").NullIfZero(),
+			},
+			{
+				Name:        "resource_group",
+				Description: "The resource group of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ResourceGroup"),
+			},
+			{
+				Name:        "cost_unit",
+				Description: "The cost center to which the instance is allocated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.CostUnit"),
+			},
+			{
+				Name:        "nick_name",
+				Description: "The name of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.NickName"),
+			},
+			{
+				Name:        "region_name",
+				Description: "The name of the region of the instance, such as China (Hangzhou).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.Region"),
+			},
+			{
+				Name:        "zone",
+				Description: "The zone of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.Zone"),
+			},
+			{
+				Name:        "list_price",
+				Description: "The unit price of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ListPrice"),
+			},
+			{
+				Name:        "list_price_unit",
+				Description: "The unit of the unit price of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ListPriceUnit"),
+			},
+			{
+				Name:        "usage",
+				Description: "The usage of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.Usage"),
+			},
+			{
+				Name:        "usage_unit",
+				Description: "The unit of the usage of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.UsageUnit"),
+			},
+			{
+				Name:        "pretax_gross_amount",
+				Description: "The amount before discounts.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.PretaxGrossAmount"),
+			},
+			{
+				Name:        "invoice_discount",
+				Description: "The discount amount.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.InvoiceDiscount"),
+			},
+			{
+				Name:        "deducted_by_coupons",
+				Description: "The amount deducted by coupons.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.DeductedByCoupons"),
+			},
+			{
+				Name:        "deducted_by_cash_coupons",
+				Description: "The amount deducted by vouchers.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.DeductedByCashCoupons"),
+			},
+			{
+				Name:        "deducted_by_prepaid_card",
+				Description: "The amount deducted by prepaid cards.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.DeductedByPrepaidCard"),
+			},
+			{
+				Name:        "deducted_by_resource_package",
+				Description: "The usage deducted by resource packages.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.DeductedByResourcePackage"),
+			},
+			{
+				Name:        "pretax_amount",
+				Description: "The amount after discounts and deductions.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.PretaxAmount"),
+			},
+			{
+				Name:        "payment_amount",
+				Description: "The amount paid in cash.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.PaymentAmount"),
+			},
+			{
+				Name:        "outstanding_amount",
+				Description: "The unpaid amount.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.OutstandingAmount"),
+			},
+			{
+				Name:        "cash_amount",
+				Description: "The amount paid from the balance of the account.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Item.CashAmount"),
+			},
+			{
+				Name:        "currency",
+				Description: "The currency of the amounts, such as CNY or USD.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.Currency"),
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the account that owns the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.OwnerID"),
+			},
+			{
+				Name:        "bill_account_id",
+				Description: "The ID of the account to which the bill belongs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.BillAccountID"),
+			},
+			{
+				Name:        "bill_account_name",
+				Description: "The name of the account to which the bill belongs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.BillAccountName"),
+			},
+			{
+				Name:        "tags",
+				Description: "A map of the tags of the instance when it was billed.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Item.Tag").Transform(bssTagsToMap),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.InstanceID"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBssInstanceBills(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	billingCycle := d.EqualsQualString("billing_cycle")
+	billingDates, err := getBssBillingDates(billingCycle, d.EqualsQualString("billing_date"))
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_bss_instance_bill.listBssInstanceBills", "qual_error", err)
+		return nil, err
+	}
+
+	// Create service connection
+	client, err := BssService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_bss_instance_bill.listBssInstanceBills", "connection_error", err)
+		return nil, err
+	}
+
+	request := bssopenapi.CreateQueryInstanceBillRequest()
+	request.Scheme = "https"
+	request.BillingCycle = billingCycle
+	request.Granularity = "DAILY"
+	request.ProductCode = d.EqualsQualString("product_code")
+	request.ProductType = d.EqualsQualString("product_type")
+	request.SubscriptionType = d.EqualsQualString("subscription_type")
+	request.PageSize = requests.NewInteger(300)
+
+	// The daily bills are queried one day at a time
+	for _, billingDate := range billingDates {
+		request.BillingDate = billingDate
+		request.PageNum = requests.NewInteger(1)
+
+		count := 0
+		for {
+			d.WaitForListRateLimit(ctx)
+			response, err := client.QueryInstanceBill(request)
+			if err != nil {
+				plugin.Logger(ctx).Error("alicloud_bss_instance_bill.listBssInstanceBills", "query_error", err, "request", request)
+				return nil, err
+			}
+
+			for _, item := range response.Data.Items.Item {
+				d.StreamListItem(ctx, bssInstanceBillItem{BillingCycle: billingCycle, Item: item})
+				// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+				count++
+			}
+
+			if len(response.Data.Items.Item) == 0 || count >= response.Data.TotalCount {
+				break
+			}
+
+			pgNumber, err := request.PageNum.GetValue()
+			if err != nil {
+				return nil, err
+			}
+
+			request.PageNum = requests.NewInteger(pgNumber + 1)
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// getBssBillingDates returns the days of a billing cycle up to today, or the
+// billing date if one is given
+func getBssBillingDates(billingCycle string, billingDate string) ([]string, error) {
+	start, err := time.Parse(bssBillingCycleFormat, billingCycle)
+	if err != nil {
+		return nil, fmt.Errorf("invalid billing_cycle %q, must be of the form YYYY-MM", billingCycle)
+	}
+
+	if billingDate != "" {
+		date, err := time.Parse(bssBillingDateFormat, billingDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid billing_date %q, must be of the form YYYY-MM-DD", billingDate)
+		}
+		if date.Format(bssBillingCycleFormat) != billingCycle {
+			return nil, nil
+		}
+		return []string{billingDate}, nil
+	}
+
+	var billingDates []string
+	today := time.Now()
+	for date := start; date.Month() == start.Month() && !date.After(today); date = date.AddDate(0, 0, 1) {
+		billingDates = append(billingDates, date.Format(bssBillingDateFormat))
+	}
+	return billingDates, nil
+}
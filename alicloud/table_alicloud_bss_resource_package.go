@@ -0,0 +1,182 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudBssResourcePackage(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_bss_resource_package",
+		Description: "The resource packages of the account, which deduct the usage of pay-as-you-go resources.",
+		List: &plugin.ListConfig{
+			Hydrate: listBssResourcePackages,
+			Tags:    map[string]string{"service": "bssopenapi", "action": "QueryResourcePackageInstances"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "product_code", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "instance_id",
+				Description: "The ID of the resource package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "package_type",
+				Description: "The type of the resource package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the resource package. Possible values are: Available, Expired.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "remark",
+				Description: "The remarks of the resource package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_code",
+				Description: "The code of the product of the resource package, used to filter the resource packages.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("product_code"),
+			},
+			{
+				Name:        "commodity_code",
+				Description: "The code of the service of the resource package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deduct_type",
+				Description: "The type of usage deducted by the resource package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "total_amount",
+				Description: "The total usage of the resource package.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("TotalAmount").Transform(bssAmountToDouble),
+			},
+			{
+				Name:        "total_amount_unit",
+				Description: "The unit of the total usage of the resource package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "remaining_amount",
+				Description: "The remaining usage of the resource package.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("RemainingAmount").Transform(bssAmountToDouble),
+			},
+			{
+				Name:        "remaining_amount_unit",
+				Description: "The unit of the remaining usage of the resource package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "effective_time",
+				Description: "The time when the resource package takes effect.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "expiry_time",
+				Description: "The time when the resource package expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "package_region",
+				Description: "The region in which the resource package can be used.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Region"),
+			},
+			{
+				Name:        "applicable_products",
+				Description: "The products to which the resource package applies.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ApplicableProducts.Product"),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceId"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBssResourcePackages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := BssService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_bss_resource_package.listBssResourcePackages", "connection_error", err)
+		return nil, err
+	}
+
+	request := bssopenapi.CreateQueryResourcePackageInstancesRequest()
+	request.Scheme = "https"
+	request.ProductCode = d.EqualsQualString("product_code")
+	request.PageSize = requests.NewInteger(100)
+	request.PageNum = requests.NewInteger(1)
+
+	count := 0
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.QueryResourcePackageInstances(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_bss_resource_package.listBssResourcePackages", "query_error", err, "request", request)
+			return nil, err
+		}
+
+		for _, resourcePackage := range response.Data.Instances.Instance {
+			d.StreamListItem(ctx, resourcePackage)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+			count++
+		}
+
+		if len(response.Data.Instances.Instance) == 0 || count >= response.Total {
+			break
+		}
+
+		pgNumber, err := request.PageNum.GetValue()
+		if err != nil {
+			return nil, err
+		}
+
+		request.PageNum = requests.NewInteger(pgNumber + 1)
+	}
+
+	return nil, nil
+}
@@ -256,7 +256,7 @@ func getUserCertificateAka(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:cas:" + region + ":" + accountID + ":certificate/" + strconv.Itoa(int(data))}
+	akas := []string{buildArn("cas", region, accountID, "certificate", strconv.Itoa(int(data)))}
 
 	return akas, nil
 }
@@ -250,7 +250,7 @@ func getCmsMonitoringHostAka(ctx context.Context, d *plugin.QueryData, h *plugin
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"arn:acs:cms:" + data.Region + ":" + accountID + ":host/" + data.HostName}
+	akas := []string{buildArn("cms", data.Region, accountID, "host", data.HostName)}
 
 	return akas, nil
 }
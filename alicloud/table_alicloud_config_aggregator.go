@@ -0,0 +1,176 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/config"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudConfigAggregator(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_config_aggregator",
+		Description: "Alibaba Cloud Config account groups, which aggregate the resources and compliance results of several accounts.",
+		List: &plugin.ListConfig{
+			Hydrate: listConfigAggregators,
+			Tags:    map[string]string{"service": "config", "action": "ListAggregators"},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("aggregator_id"),
+			Hydrate:    getConfigAggregator,
+			Tags:       map[string]string{"service": "config", "action": "GetAggregator"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"AccountNotExisted", "Invalid.AggregatorId.Value"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getConfigAggregator,
+				Tags: map[string]string{"service": "config", "action": "GetAggregator"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "aggregator_id",
+				Description: "The ID of the account group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "aggregator_name",
+				Description: "The name of the account group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "aggregator_type",
+				Description: "The type of the account group. Possible values are: RD for a global account group and CUSTOM for a custom account group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the account group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "aggregator_status",
+				Description: "The status of the account group. Possible values are: 0 (creating) and 1 (created).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "aggregator_account_count",
+				Description: "The number of accounts in the account group.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the account group was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AggregatorCreateTimestamp").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "aggregator_accounts",
+				Description: "The accounts in the account group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigAggregator,
+				Transform:   transform.FromField("AggregatorAccounts"),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AggregatorName"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listConfigAggregators(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_config_aggregator.listConfigAggregators", "connection_error", err)
+		return nil, err
+	}
+
+	request := config.CreateListAggregatorsRequest()
+	request.Scheme = "https"
+	request.MaxResults = requests.NewInteger(100)
+
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.ListAggregators(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_config_aggregator.listConfigAggregators", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, aggregator := range response.AggregatorsResult.Aggregators {
+			d.StreamListItem(ctx, aggregator)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.AggregatorsResult.NextToken == "" {
+			break
+		}
+		request.NextToken = response.AggregatorsResult.NextToken
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getConfigAggregator(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = h.Item.(config.AggregatorsItem).AggregatorId
+	} else {
+		id = d.EqualsQualString("aggregator_id")
+	}
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	client, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_config_aggregator.getConfigAggregator", "connection_error", err)
+		return nil, err
+	}
+
+	request := config.CreateGetAggregatorRequest()
+	request.Scheme = "https"
+	request.AggregatorId = id
+
+	response, err := client.GetAggregator(request)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_config_aggregator.getConfigAggregator", "query_error", err, "request", request)
+		return nil, err
+	}
+	return response.Aggregator, nil
+}
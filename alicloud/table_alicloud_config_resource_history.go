@@ -0,0 +1,174 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/config"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudConfigResourceHistory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_config_resource_history",
+		Description: "Alibaba Cloud Config configuration timeline of a resource, one row per recorded change.",
+		List: &plugin.ListConfig{
+			Hydrate: listConfigResourceHistory,
+			Tags:    map[string]string{"service": "config", "action": "GetResourceConfigurationTimeline"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "resource_id", Require: plugin.Required},
+				{Name: "resource_type", Require: plugin.Required},
+				{Name: "region", Require: plugin.Required},
+				{Name: "capture_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("resource_id"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource, such as ACS::ECS::Instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("resource_type"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "capture_time",
+				Description: "The time when the configuration change was recorded.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CaptureTime").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "resource_event_type",
+				Description: "The type of the change. Possible values are: DISCOVERED, MODIFY, DELETED and RECOVERED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "configuration_diff",
+				Description: "The configuration changes of the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConfigurationDiff").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "relationship",
+				Description: "The resources related to the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Relationship").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "relationship_diff",
+				Description: "The changes of the resources related to the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RelationshipDiff").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The zone of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_create_time",
+				Description: "The time when the resource was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ResourceCreateTime").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(transform.UnmarshalYAML),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("resource_id"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("region"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listConfigResourceHistory(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_config_resource_history.listConfigResourceHistory", "connection_error", err)
+		return nil, err
+	}
+
+	request := config.CreateGetResourceConfigurationTimelineRequest()
+	request.Scheme = "https"
+	request.ResourceId = d.EqualsQualString("resource_id")
+	request.ResourceType = d.EqualsQualString("resource_type")
+	request.Region = d.EqualsQualString("region")
+	request.MaxResults = requests.NewInteger(100)
+
+	if d.Quals["capture_time"] != nil {
+		for _, q := range d.Quals["capture_time"].Quals {
+			captureTime := requests.NewInteger64(q.Value.GetTimestampValue().AsTime().UnixMilli())
+			switch q.Operator {
+			case ">", ">=":
+				request.StartTime = captureTime
+			case "<", "<=":
+				request.EndTime = captureTime
+			case "=":
+				request.StartTime = captureTime
+				request.EndTime = captureTime
+			}
+		}
+	}
+
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.GetResourceConfigurationTimeline(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_config_resource_history.listConfigResourceHistory", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, item := range response.ResourceConfigurationTimeline.ConfigurationList {
+			d.StreamListItem(ctx, item)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.ResourceConfigurationTimeline.NextToken == "" {
+			break
+		}
+		request.NextToken = response.ResourceConfigurationTimeline.NextToken
+	}
+	return nil, nil
+}
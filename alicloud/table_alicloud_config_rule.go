@@ -0,0 +1,326 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/config"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudConfigRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_config_rule",
+		Description: "Alibaba Cloud Config rules, which evaluate the configuration of resources.",
+		List: &plugin.ListConfig{
+			Hydrate: listConfigRules,
+			Tags:    map[string]string{"service": "config", "action": "ListConfigRules"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "config_rule_state", Require: plugin.Optional},
+				{Name: "compliance_type", Require: plugin.Optional},
+				{Name: "risk_level", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("config_rule_id"),
+			Hydrate:    getConfigRule,
+			Tags:       map[string]string{"service": "config", "action": "GetConfigRule"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ConfigRuleNotExists"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getConfigRule,
+				Tags: map[string]string{"service": "config", "action": "GetConfigRule"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "config_rule_id",
+				Description: "The ID of the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "config_rule_name",
+				Description: "The name of the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Alibaba Cloud Resource Name (ARN) of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigRuleArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "config_rule_state",
+				Description: "The status of the rule. Possible values are: ACTIVE, DELETING, EVALUATING and INACTIVE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "risk_level",
+				Description: "The risk level of the resources that do not comply with the rule. Possible values are: 1 (high), 2 (medium) and 3 (low).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "compliance_type",
+				Description: "The compliance evaluation result of the rule. Possible values are: COMPLIANT, NON_COMPLIANT, NOT_APPLICABLE and INSUFFICIENT_DATA.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Compliance.ComplianceType"),
+			},
+			{
+				Name:        "non_compliant_resource_count",
+				Description: "The number of resources that do not comply with the rule.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Compliance.Count"),
+			},
+			{
+				Name:        "source_owner",
+				Description: "The type of the rule. Possible values are: ALIYUN for managed rules and CUSTOM_FC for custom rules.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConfigRule,
+				Transform:   transform.FromField("Source.Owner"),
+			},
+			{
+				Name:        "source_identifier",
+				Description: "The identifier of the managed rule, or the ARN of the Function Compute function of the custom rule.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConfigRule,
+				Transform:   transform.FromField("Source.Identifier"),
+			},
+			{
+				Name:        "trigger_types",
+				Description: "The trigger types of the rule. Possible values are: ConfigurationItemChangeNotification and ScheduledNotification.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigRule,
+				Transform:   transform.FromField("ConfigRuleTriggerTypes").Transform(splitCommaSeparatedString),
+			},
+			{
+				Name:        "maximum_execution_frequency",
+				Description: "The interval at which the rule is triggered periodically, such as TwentyFour_Hours.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConfigRule,
+			},
+			{
+				Name:        "resource_types_scope",
+				Description: "The types of the resources evaluated by the rule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigRule,
+				Transform:   transform.FromField("Scope.ComplianceResourceTypes"),
+			},
+			{
+				Name:        "region_ids_scope",
+				Description: "The regions the rule applies to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigRule,
+				Transform:   transform.FromField("RegionIdsScope").Transform(splitCommaSeparatedString),
+			},
+			{
+				Name:        "resource_group_ids_scope",
+				Description: "The resource groups the rule applies to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigRule,
+				Transform:   transform.FromField("ResourceGroupIdsScope").Transform(splitCommaSeparatedString),
+			},
+			{
+				Name:        "exclude_resource_ids_scope",
+				Description: "The resources excluded from the rule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigRule,
+				Transform:   transform.FromField("ExcludeResourceIdsScope").Transform(splitCommaSeparatedString),
+			},
+			{
+				Name:        "tag_key_scope",
+				Description: "The tag key of the resources the rule applies to.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConfigRule,
+			},
+			{
+				Name:        "tag_value_scope",
+				Description: "The tag value of the resources the rule applies to.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConfigRule,
+			},
+			{
+				Name:        "input_parameters",
+				Description: "The input parameters of the rule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigRule,
+			},
+			{
+				Name:        "evaluation_status",
+				Description: "The status of the last evaluation of the rule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigRule,
+				Transform:   transform.FromField("ConfigRuleEvaluationStatus"),
+			},
+			{
+				Name:        "create_by",
+				Description: "The creator of the rule, such as a compliance package or an aggregator.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigRule,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the rule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getConfigRule,
+				Transform:   transform.FromField("CreateTimestamp").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "modified_time",
+				Description: "The time when the rule was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getConfigRule,
+				Transform:   transform.FromField("ModifiedTimestamp").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached with the rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(configRuleTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigRuleName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConfigRuleArn").Transform(ensureStringArray),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listConfigRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_config_rule.listConfigRules", "connection_error", err)
+		return nil, err
+	}
+
+	request := config.CreateListConfigRulesRequest()
+	request.Scheme = "https"
+	request.PageSize = requests.NewInteger(100)
+	request.PageNumber = requests.NewInteger(1)
+	if state := d.EqualsQualString("config_rule_state"); state != "" {
+		request.ConfigRuleState = state
+	}
+	if complianceType := d.EqualsQualString("compliance_type"); complianceType != "" {
+		request.ComplianceType = complianceType
+	}
+	if d.EqualsQuals["risk_level"] != nil {
+		request.RiskLevel = requests.NewInteger(int(d.EqualsQuals["risk_level"].GetInt64Value()))
+	}
+
+	count := 0
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.ListConfigRules(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_config_rule.listConfigRules", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, rule := range response.ConfigRules.ConfigRuleList {
+			d.StreamListItem(ctx, rule)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+			count++
+		}
+		if int64(count) >= response.ConfigRules.TotalCount || len(response.ConfigRules.ConfigRuleList) == 0 {
+			break
+		}
+		request.PageNumber = requests.NewInteger(response.ConfigRules.PageNumber + 1)
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getConfigRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = h.Item.(config.ConfigRule).ConfigRuleId
+	} else {
+		id = d.EqualsQualString("config_rule_id")
+	}
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	client, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_config_rule.getConfigRule", "connection_error", err)
+		return nil, err
+	}
+
+	request := config.CreateGetConfigRuleRequest()
+	request.Scheme = "https"
+	request.ConfigRuleId = id
+
+	response, err := client.GetConfigRule(request)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_config_rule.getConfigRule", "query_error", err, "request", request)
+		return nil, err
+	}
+	return response.ConfigRule, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func configRuleTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]config.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[i.Key] = i.Value
+	}
+	return turbotTagsMap, nil
+}
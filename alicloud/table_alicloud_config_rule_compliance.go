@@ -0,0 +1,197 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/config"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudConfigRuleCompliance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_config_rule_compliance",
+		Description: "Alibaba Cloud Config rule evaluation results, one row per rule and resource.",
+		List: &plugin.ListConfig{
+			Hydrate: listConfigRuleCompliance,
+			Tags:    map[string]string{"service": "config", "action": "ListConfigRuleEvaluationResults"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "config_rule_id", Require: plugin.Optional},
+				{Name: "compliance_type", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "region", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "config_rule_id",
+				Description: "The ID of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EvaluationResultIdentifier.EvaluationResultQualifier.ConfigRuleId"),
+			},
+			{
+				Name:        "config_rule_name",
+				Description: "The name of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EvaluationResultIdentifier.EvaluationResultQualifier.ConfigRuleName"),
+			},
+			{
+				Name:        "config_rule_arn",
+				Description: "The Alibaba Cloud Resource Name (ARN) of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EvaluationResultIdentifier.EvaluationResultQualifier.ConfigRuleArn"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the evaluated resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EvaluationResultIdentifier.EvaluationResultQualifier.ResourceId"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the evaluated resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EvaluationResultIdentifier.EvaluationResultQualifier.ResourceName"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the evaluated resource, such as ACS::ECS::Instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EvaluationResultIdentifier.EvaluationResultQualifier.ResourceType"),
+			},
+			{
+				Name:        "resource_owner_id",
+				Description: "The ID of the account that owns the evaluated resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EvaluationResultIdentifier.EvaluationResultQualifier.ResourceOwnerId"),
+			},
+			{
+				Name:        "compliance_type",
+				Description: "The compliance evaluation result. Possible values are: COMPLIANT, NON_COMPLIANT, NOT_APPLICABLE and INSUFFICIENT_DATA.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "risk_level",
+				Description: "The risk level of the rule. Possible values are: 1 (high), 2 (medium) and 3 (low).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "annotation",
+				Description: "Details of the evaluation, such as the expected and actual values.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Annotation").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "compliance_pack_id",
+				Description: "The ID of the compliance package the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EvaluationResultIdentifier.EvaluationResultQualifier.CompliancePackId").NullIfZero(),
+			},
+			{
+				Name:        "invoking_event_message_type",
+				Description: "The trigger of the evaluation. Possible values are: ConfigurationItemChangeNotification, ScheduledNotification and Manual.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "remediation_enabled",
+				Description: "Indicates whether remediation is enabled for the rule.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "ordering_time",
+				Description: "The time when the evaluation was triggered.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("EvaluationResultIdentifier.OrderingTimestamp").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "config_rule_invoked_time",
+				Description: "The time when the rule was invoked.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ConfigRuleInvokedTimestamp").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "result_recorded_time",
+				Description: "The time when the evaluation result was recorded.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ResultRecordedTimestamp").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EvaluationResultIdentifier.EvaluationResultQualifier.ResourceId"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EvaluationResultIdentifier.EvaluationResultQualifier.RegionId"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listConfigRuleCompliance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_config_rule_compliance.listConfigRuleCompliance", "connection_error", err)
+		return nil, err
+	}
+
+	request := config.CreateListConfigRuleEvaluationResultsRequest()
+	request.Scheme = "https"
+	request.MaxResults = requests.NewInteger(100)
+	if ruleId := d.EqualsQualString("config_rule_id"); ruleId != "" {
+		request.ConfigRuleId = ruleId
+	}
+	if complianceType := d.EqualsQualString("compliance_type"); complianceType != "" {
+		request.ComplianceType = complianceType
+	}
+	if resourceType := d.EqualsQualString("resource_type"); resourceType != "" {
+		request.ResourceTypes = resourceType
+	}
+	if region := d.EqualsQualString("region"); region != "" {
+		request.Regions = region
+	}
+
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.ListConfigRuleEvaluationResults(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_config_rule_compliance.listConfigRuleCompliance", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, result := range response.EvaluationResults.EvaluationResultList {
+			d.StreamListItem(ctx, result)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.EvaluationResults.NextToken == "" {
+			break
+		}
+		request.NextToken = response.EvaluationResults.NextToken
+	}
+	return nil, nil
+}
@@ -0,0 +1,404 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/endpoints"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// connectionDiagnosticService is a service checked by the
+// alicloud_connection_diagnostic table
+type connectionDiagnosticService struct {
+	// Service is the name of the service in the endpoints connection option
+	Service string
+	// Product is the product code of the service in the core SDK
+	Product string
+	// Global services are only checked in the default region
+	Global bool
+	// Region returns the region the client of a global service is created
+	// in, when it is not the default region
+	Region func(d *plugin.QueryData) string
+	// Client creates the client of the service in the region of the matrix
+	Client func(ctx context.Context, d *plugin.QueryData, region string) (interface{}, error)
+	// Endpoint resolves the endpoint of the clients that are not built on
+	// the core SDK
+	Endpoint func(d *plugin.QueryData, region string) (string, error)
+}
+
+// connectionDiagnosticServices are the services used by the tables of the plugin
+var connectionDiagnosticServices = []connectionDiagnosticService{
+	{Service: "actiontrail", Product: "Actiontrail", Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return ActionTrailService(ctx, d)
+	}},
+	{Service: "alidns", Product: "Alidns", Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return AliDNSService(ctx, d)
+	}},
+	{Service: "bssopenapi", Product: "BssOpenApi", Global: true, Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return BssService(ctx, d)
+	}},
+	{Service: "cas", Product: "cas", Client: func(ctx context.Context, d *plugin.QueryData, region string) (interface{}, error) {
+		return CasService(ctx, d, region)
+	}},
+	{Service: "cms", Product: "Cms", Global: true, Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return CmsService(ctx, d)
+	}},
+	{Service: "config", Product: "Config", Global: true, Region: configServiceRegion, Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return ConfigService(ctx, d)
+	}},
+	{Service: "cs", Product: "CS", Global: true, Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return ContainerService(ctx, d)
+	}},
+	{Service: "ecs", Product: "Ecs", Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return ECSService(ctx, d)
+	}},
+	{Service: "ess", Product: "Ess", Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return AutoscalingService(ctx, d)
+	}},
+	{Service: "ims", Product: "Ims", Global: true, Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return IMSService(ctx, d)
+	}},
+	{Service: "kms", Product: "Kms", Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return KMSService(ctx, d)
+	}},
+	{Service: "oss", Endpoint: getOssEndpoint},
+	{Service: "ram", Product: "Ram", Global: true, Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return RAMService(ctx, d)
+	}},
+	{Service: "rds", Product: "Rds", Client: func(ctx context.Context, d *plugin.QueryData, region string) (interface{}, error) {
+		return RDSService(ctx, d, region)
+	}},
+	{Service: "resourcecenter", Product: "ResourceCenter", Global: true, Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return ResourceCenterService(ctx, d)
+	}},
+	{Service: "resourcemanager", Product: "ResourceManager", Global: true, Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return ResourceManagerService(ctx, d)
+	}},
+	{Service: "sas", Product: "Sas", Client: func(ctx context.Context, d *plugin.QueryData, region string) (interface{}, error) {
+		return SecurityCenterService(ctx, d, region)
+	}},
+	{Service: "slb", Product: "Slb", Global: true, Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return SLBService(ctx, d)
+	}},
+	{Service: "sls", Endpoint: getSlsEndpoint},
+	{Service: "sts", Product: "Sts", Global: true, Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return StsService(ctx, d)
+	}},
+	{Service: "tag", Product: "Tag", Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return TagService(ctx, d)
+	}},
+	{Service: "vpc", Product: "Vpc", Client: func(ctx context.Context, d *plugin.QueryData, _ string) (interface{}, error) {
+		return VpcService(ctx, d)
+	}},
+}
+
+// connectionDiagnostic is the result of the checks of a service in a region
+type connectionDiagnostic struct {
+	Service      string
+	Region       string
+	Regions      []string
+	Endpoint     string
+	EndpointType string
+	Site         string
+	Reachable    bool
+	StatusCode   int
+	Latency      int64
+	Error        string
+}
+
+// connectionDiagnosticIdentity is the identity of the connection, or the
+// error returned when resolving it
+type connectionDiagnosticIdentity struct {
+	AccountId     string
+	Arn           string
+	IdentityType  string
+	PrincipalId   string
+	IdentityError string
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudConnectionDiagnostic(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_connection_diagnostic",
+		Description: "Checks of the connection config, identity and service endpoints of the connection, one row per service and region.",
+		List: &plugin.ListConfig{
+			Hydrate: listConnectionDiagnostics,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "service", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "service",
+				Description: "The name of the service, as used in the endpoints connection option. The row of the connection_config service reports errors in the connection config.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint",
+				Description: "The endpoint the plugin connects to for the service. Null if the endpoint is resolved by the location service at request time.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint_type",
+				Description: "The endpoint type of the connection. Possible values are: public, vpc and intl.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "site",
+				Description: "The site of the connection. Possible values are: china and international.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reachable",
+				Description: "True if the endpoint answered an HTTPS request.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "status_code",
+				Description: "The HTTP status code returned by the endpoint.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "latency_ms",
+				Description: "The time taken by the endpoint to answer, in milliseconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Latency"),
+			},
+			{
+				Name:        "error",
+				Description: "The error returned when creating the client of the service or connecting to its endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "regions",
+				Description: "The regions queried by the connection.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "identity_arn",
+				Description: "The ARN of the identity of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConnectionDiagnosticIdentity,
+				Transform:   transform.FromField("Arn"),
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of the identity of the connection, such as Account, RAMUser or AssumedRoleUser.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConnectionDiagnosticIdentity,
+			},
+			{
+				Name:        "principal_id",
+				Description: "The ID of the principal of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConnectionDiagnosticIdentity,
+			},
+			{
+				Name:        "identity_error",
+				Description: "The error returned when resolving the identity of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConnectionDiagnosticIdentity,
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Service"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConnectionDiagnosticIdentity,
+				Transform:   transform.FromField("AccountId"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listConnectionDiagnostics(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	defaultRegion := GetDefaultRegion(d.Connection)
+	alicloudConfig := GetConfig(d.Connection)
+
+	regions := alicloudConfig.Regions
+	if regions == nil {
+		regions = []string{defaultRegion}
+	}
+	endpointType, _ := getEndpointType(d)
+
+	// Errors in the connection config fail every service, so they are
+	// reported once instead
+	if err := validateConfig(alicloudConfig); err != nil {
+		if region == defaultRegion {
+			d.StreamListItem(ctx, connectionDiagnostic{
+				Service:      "connection_config",
+				Region:       region,
+				Regions:      regions,
+				EndpointType: endpointType,
+				Site:         getSite(d.Connection),
+				Error:        err.Error(),
+			})
+		}
+		return nil, nil
+	}
+
+	for _, service := range connectionDiagnosticServices {
+		if d.EqualsQualString("service") != "" && d.EqualsQualString("service") != service.Service {
+			continue
+		}
+		if service.Global && region != defaultRegion {
+			continue
+		}
+
+		diagnostic := connectionDiagnostic{
+			Service:      service.Service,
+			Region:       region,
+			Regions:      regions,
+			EndpointType: endpointType,
+			Site:         getSite(d.Connection),
+		}
+
+		endpoint, err := getConnectionDiagnosticEndpoint(ctx, d, service, region)
+		if err != nil {
+			diagnostic.Error = err.Error()
+		} else if endpoint != "" {
+			diagnostic.Endpoint = endpoint
+			statusCode, latency, err := checkEndpointReachable(ctx, d, endpoint)
+			diagnostic.StatusCode = statusCode
+			diagnostic.Latency = latency.Milliseconds()
+			if err != nil {
+				diagnostic.Error = err.Error()
+			} else {
+				diagnostic.Reachable = true
+			}
+		}
+
+		d.StreamListItem(ctx, diagnostic)
+		// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getConnectionDiagnosticIdentity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Failing to resolve the identity is a result of the diagnostic, not an
+	// error of the query
+	callerIdentityData, err := getAccountDetails(ctx, d, h)
+	if err != nil {
+		return &connectionDiagnosticIdentity{IdentityError: err.Error()}, nil
+	}
+
+	callerIdentity := callerIdentityData.(*sts.GetCallerIdentityResponse)
+	return &connectionDiagnosticIdentity{
+		AccountId:    callerIdentity.AccountId,
+		Arn:          callerIdentity.Arn,
+		IdentityType: callerIdentity.IdentityType,
+		PrincipalId:  callerIdentity.PrincipalId,
+	}, nil
+}
+
+// getConnectionDiagnosticEndpoint returns the endpoint the client of a
+// service connects to in a region
+func getConnectionDiagnosticEndpoint(ctx context.Context, d *plugin.QueryData, service connectionDiagnosticService, region string) (string, error) {
+	if service.Endpoint != nil {
+		return service.Endpoint(d, region)
+	}
+
+	if service.Region != nil {
+		region = service.Region(d)
+	}
+
+	client, err := service.Client(ctx, d, region)
+	if err != nil {
+		return "", err
+	}
+	return getSdkClientEndpoint(client, region, service.Product)
+}
+
+// getSdkClientEndpoint resolves the endpoint of a core SDK client in the same
+// order as the SDK does when it sends a request
+func getSdkClientEndpoint(client interface{}, region string, product string) (string, error) {
+	field := reflect.ValueOf(client).Elem().FieldByName("Client")
+	if !field.IsValid() {
+		return "", fmt.Errorf("%T is not a core SDK client", client)
+	}
+	sdkClient := field.Addr().Interface().(*sdk.Client)
+
+	if sdkClient.Domain != "" {
+		return sdkClient.Domain, nil
+	}
+	if endpoint := endpoints.GetEndpointFromMap(region, product); endpoint != "" {
+		return endpoint, nil
+	}
+	if sdkClient.EndpointType != "" {
+		if sdkClient.Network == "" || sdkClient.Network == "public" {
+			if endpoint := sdkClient.EndpointMap[region]; endpoint != "" {
+				return endpoint, nil
+			}
+		}
+		return sdkClient.GetEndpointRules(region, product)
+	}
+
+	// The endpoint is resolved by the location service at request time
+	return "", nil
+}
+
+// checkEndpointReachable sends an HTTPS request to an endpoint through the
+// proxy and TLS options of the connection. Any HTTP response means the
+// endpoint is reachable.
+func checkEndpointReachable(ctx context.Context, d *plugin.QueryData, endpoint string) (int, time.Duration, error) {
+	transport, err := getHTTPTransport(d)
+	if err != nil {
+		return 0, 0, err
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	if transport != nil {
+		client.Transport = transport
+	}
+	if timeout := GetConfig(d.Connection).Timeout; timeout != nil {
+		client.Timeout = time.Duration(*timeout) * time.Second
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint+"/", nil)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start := time.Now()
+	response, err := client.Do(request)
+	latency := time.Since(start)
+	if err != nil {
+		return 0, latency, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode, latency, nil
+}
@@ -0,0 +1,295 @@
+package alicloud
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudControlPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_control_policy",
+		Description: "Alibaba Cloud Resource Directory control policies and the folders and accounts they are attached to.",
+		List: &plugin.ListConfig{
+			Hydrate: listControlPolicies,
+			Tags:    map[string]string{"service": "resourcemanager", "action": "ListControlPolicies"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "policy_type", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("policy_id"),
+			Hydrate:    getControlPolicy,
+			Tags:       map[string]string{"service": "resourcemanager", "action": "GetControlPolicy"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"EntityNotExist.ControlPolicy"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getControlPolicy,
+				Tags: map[string]string{"service": "resourcemanager", "action": "GetControlPolicy"},
+			},
+			{
+				Func: listControlPolicyTargetAttachments,
+				Tags: map[string]string{"service": "resourcemanager", "action": "ListTargetAttachmentsForControlPolicy"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "policy_id",
+				Description: "The ID of the control policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_name",
+				Description: "The name of the control policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_type",
+				Description: "The type of the control policy. Possible values are: System and Custom.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the control policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "effect_scope",
+				Description: "The effective scope of the control policy. Possible values are: All and RAM.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "attachment_count",
+				Description: "The number of folders and accounts the control policy is attached to.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("AttachmentCount").Transform(controlPolicyAttachmentCount),
+			},
+			{
+				Name:        "create_date",
+				Description: "The time when the control policy was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "update_date",
+				Description: "The time when the control policy was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateDate").NullIfZero(),
+			},
+			{
+				Name:        "policy_document",
+				Description: "The document of the control policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getControlPolicy,
+				Transform:   transform.FromField("PolicyDocument").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "policy_document_std",
+				Description: "Contains the policy document in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getControlPolicy,
+				Transform:   transform.FromField("PolicyDocument").Transform(policyToCanonical),
+			},
+			{
+				Name:        "target_attachments",
+				Description: "The folders and accounts the control policy is attached to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listControlPolicyTargetAttachments,
+				Transform:   transform.FromValue(),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyName"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listControlPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := ResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_control_policy.listControlPolicies", "connection_error", err)
+		return nil, err
+	}
+
+	request := resourcemanager.CreateListControlPoliciesRequest()
+	request.Scheme = "https"
+	request.PageSize = requests.NewInteger(100)
+	request.PageNumber = requests.NewInteger(1)
+	if policyType := d.EqualsQualString("policy_type"); policyType != "" {
+		request.PolicyType = policyType
+	}
+
+	count := 0
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.ListControlPolicies(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_control_policy.listControlPolicies", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, policy := range response.ControlPolicies.ControlPolicy {
+			d.StreamListItem(ctx, policy)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+			count++
+		}
+		if count >= response.TotalCount || len(response.ControlPolicies.ControlPolicy) == 0 {
+			break
+		}
+		request.PageNumber = requests.NewInteger(response.PageNumber + 1)
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getControlPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = h.Item.(resourcemanager.ControlPolicy).PolicyId
+	} else {
+		id = d.EqualsQualString("policy_id")
+	}
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	client, err := ResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_control_policy.getControlPolicy", "connection_error", err)
+		return nil, err
+	}
+
+	request := resourcemanager.CreateGetControlPolicyRequest()
+	request.Scheme = "https"
+	request.PolicyId = id
+
+	response, err := client.GetControlPolicy(request)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_control_policy.getControlPolicy", "query_error", err, "request", request)
+		return nil, err
+	}
+	return response.ControlPolicy, nil
+}
+
+func listControlPolicyTargetAttachments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policy := h.Item.(resourcemanager.ControlPolicy)
+
+	// Create service connection
+	client, err := ResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_control_policy.listControlPolicyTargetAttachments", "connection_error", err)
+		return nil, err
+	}
+
+	request := resourcemanager.CreateListTargetAttachmentsForControlPolicyRequest()
+	request.Scheme = "https"
+	request.PolicyId = policy.PolicyId
+	request.PageSize = requests.NewInteger(100)
+	request.PageNumber = requests.NewInteger(1)
+
+	attachments := []resourcemanager.TargetAttachment{}
+	for {
+		response, err := client.ListTargetAttachmentsForControlPolicy(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_control_policy.listControlPolicyTargetAttachments", "query_error", err, "request", request)
+			return nil, err
+		}
+		attachments = append(attachments, response.TargetAttachments.TargetAttachment...)
+		if len(attachments) >= response.TotalCount || len(response.TargetAttachments.TargetAttachment) == 0 {
+			break
+		}
+		request.PageNumber = requests.NewInteger(response.PageNumber + 1)
+	}
+	return attachments, nil
+}
+
+// getControlPolicyAttachmentsForTarget returns the control policies attached
+// directly to a folder or account, memoized per target since the account
+// table looks up the same folders for many accounts
+func getControlPolicyAttachmentsForTarget(ctx context.Context, d *plugin.QueryData, targetId string) ([]resourcemanager.ControlPolicyAttachment, error) {
+	attachments, err := getControlPolicyAttachmentsForTargetMemoize(ctx, d, &plugin.HydrateData{Item: targetId})
+	if err != nil {
+		return nil, err
+	}
+	return attachments.([]resourcemanager.ControlPolicyAttachment), nil
+}
+
+var getControlPolicyAttachmentsForTargetMemoize = plugin.HydrateFunc(getControlPolicyAttachmentsForTargetUncached).Memoize(memoize.WithCacheKeyFunction(getControlPolicyAttachmentsForTargetCacheKey))
+
+func getControlPolicyAttachmentsForTargetCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cacheKey := "ListControlPolicyAttachmentsForTarget-" + h.Item.(string)
+	return cacheKey, nil
+}
+
+func getControlPolicyAttachmentsForTargetUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	targetId := h.Item.(string)
+
+	client, err := ResourceManagerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	request := resourcemanager.CreateListControlPolicyAttachmentsForTargetRequest()
+	request.Scheme = "https"
+	request.TargetId = targetId
+
+	response, err := client.ListControlPolicyAttachmentsForTarget(request)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := response.ControlPolicyAttachments.ControlPolicyAttachment
+	if attachments == nil {
+		attachments = []resourcemanager.ControlPolicyAttachment{}
+	}
+	return attachments, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func controlPolicyAttachmentCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	count, err := strconv.Atoi(d.Value.(string))
+	if err != nil {
+		return nil, nil
+	}
+	return count, nil
+}
@@ -486,9 +486,11 @@ func getCsKubernetesClusterNamespace(ctx context.Context, d *plugin.QueryData, h
 		return nil, nil
 	}
 
+	// The endpoint is resolved by the client for the product, so that the
+	// endpoints, endpoint_type and site connection options apply
 	request := requests.NewCommonRequest()
 	request.Scheme = "https"
-	request.Domain = "cs.aliyuncs.com"
+	request.Product = "CS"
 	request.Version = "2015-12-15"
 	request.PathPattern = "/k8s/" + id + "/namespaces"
 
@@ -514,7 +516,7 @@ func getCsKubernetesClusterARN(ctx context.Context, d *plugin.QueryData, h *plug
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	arn := "arn:acs:cs:" + data["region_id"].(string) + ":" + accountID + ":cluster/" + data["cluster_id"].(string)
+	arn := buildArn("cs", data["region_id"].(string), accountID, "cluster", data["cluster_id"].(string))
 
 	return arn, nil
 }
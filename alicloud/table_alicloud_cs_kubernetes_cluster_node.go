@@ -256,7 +256,7 @@ func getCsKubernetesClusterNodeAka(ctx context.Context, d *plugin.QueryData, h *
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:cs:" + strings.Split(nodeName, ".")[0] + ":" + accountID + ":node/" + nodeName}
+	akas := []string{buildArn("cs", strings.Split(nodeName, ".")[0], accountID, "node", nodeName)}
 
 	return akas, nil
 }
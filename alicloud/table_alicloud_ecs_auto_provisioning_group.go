@@ -274,7 +274,7 @@ func getEcsAutosProvisioningGroupAka(ctx context.Context, d *plugin.QueryData, h
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:ecs:" + data.RegionId + ":" + accountID + ":auto-provisioning-group/" + data.AutoProvisioningGroupId}
+	akas := []string{buildArn("ecs", data.RegionId, accountID, "auto-provisioning-group", data.AutoProvisioningGroupId)}
 
 	return akas, nil
 }
@@ -475,7 +475,7 @@ func getEcsAutoscalingGroupAka(ctx context.Context, d *plugin.QueryData, h *plug
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"arn:acs:ess:" + data.RegionId + ":" + accountID + ":scalinggroup/" + data.ScalingGroupId}
+	akas := []string{buildArn("ess", data.RegionId, accountID, "scalinggroup", data.ScalingGroupId)}
 
 	return akas, nil
 }
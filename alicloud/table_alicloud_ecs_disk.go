@@ -482,7 +482,7 @@ func getEcsDiskARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	arn := "arn:acs:ecs:" + disk.RegionId + ":" + accountID + ":disk/" + disk.DiskId
+	arn := buildArn("ecs", disk.RegionId, accountID, "disk", disk.DiskId)
 
 	return arn, nil
 }
@@ -384,7 +384,7 @@ func getEcsImageARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	arn := "arn:acs:ecs:" + region + ":" + accountID + ":image/" + data.ImageId
+	arn := buildArn("ecs", region, accountID, "image", data.ImageId)
 
 	return arn, nil
 }
@@ -695,7 +695,7 @@ func getEcsInstanceARN(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	arn := "arn:acs:ecs:" + instance.RegionId + ":" + accountID + ":instance/" + instance.InstanceId
+	arn := buildArn("ecs", instance.RegionId, accountID, "instance", instance.InstanceId)
 
 	return arn, nil
 }
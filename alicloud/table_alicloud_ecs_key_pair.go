@@ -188,7 +188,7 @@ func getEcsKeypairAka(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:ecs:" + region + ":" + accountID + ":keypair/" + data.KeyPairName}
+	akas := []string{buildArn("ecs", region, accountID, "keypair", data.KeyPairName)}
 
 	return akas, nil
 }
@@ -248,7 +248,7 @@ func getEcsLaunchTemplateAka(ctx context.Context, d *plugin.QueryData, h *plugin
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:ecs:" + region + ":" + accountID + ":launch-template/" + data.LaunchTemplateId}
+	akas := []string{buildArn("ecs", region, accountID, "launch-template", data.LaunchTemplateId)}
 
 	return akas, nil
 }
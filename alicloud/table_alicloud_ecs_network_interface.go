@@ -282,7 +282,8 @@ func getEcsEni(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 
 func ecsEniAka(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	eni := d.HydrateItem.(ecs.NetworkInterfaceSet)
-	akas := []string{"acs:ecs:" + eni.ZoneId + ":" + eni.OwnerId + ":eni/" + eni.NetworkInterfaceId}
+	region, _ := d.MatrixItem[matrixKeyRegion].(string)
+	akas := []string{buildArn("ecs", region, eni.OwnerId, "networkinterface", eni.NetworkInterfaceId)}
 
 	return akas, nil
 }
@@ -114,5 +114,5 @@ func getRegionAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	return []string{"acs:ecs::" + accountID + ":region/" + data.RegionId}, nil
+	return []string{buildArn("ecs", "", accountID, "region", data.RegionId)}, nil
 }
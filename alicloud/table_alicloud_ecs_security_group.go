@@ -274,7 +274,7 @@ func getEcsSecurityGroupARN(ctx context.Context, d *plugin.QueryData, h *plugin.
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	arn := "arn:acs:ecs:" + region + ":" + accountID + ":securitygroup/" + data.SecurityGroupId
+	arn := buildArn("ecs", region, accountID, "securitygroup", data.SecurityGroupId)
 
 	return arn, nil
 }
@@ -0,0 +1,328 @@
+package alicloud
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudEcsSecurityGroupRule(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_ecs_security_group_rule",
+		Description: "ECS Security Group Rule",
+		List: &plugin.ListConfig{
+			ParentHydrate: listEcsSecurityGroups,
+			Hydrate:       listEcsSecurityGroupRules,
+			Tags:          map[string]string{"service": "ecs", "action": "DescribeSecurityGroupAttribute"},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "security_group_rule_id",
+				Description: "The ID of the security group rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "security_group_id",
+				Description: "The ID of the security group to which the rule belongs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "security_group_name",
+				Description: "The name of the security group to which the rule belongs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC to which the security group belongs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "direction",
+				Description: "The direction in which the rule is applied. Possible values are: ingress and egress.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ip_protocol",
+				Description: "The transport layer protocol. Possible values are: TCP, UDP, ICMP, ICMPv6, GRE and ALL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port_range",
+				Description: "The range of destination ports of the transport layer protocol, in the format start/end.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "from_port",
+				Description: "The start of the destination port range. A port range of -1/-1 (all ports) is reported as 1 to 65535; the value is null for protocols without ports.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(securityGroupRuleFromPort),
+			},
+			{
+				Name:        "to_port",
+				Description: "The end of the destination port range. A port range of -1/-1 (all ports) is reported as 1 to 65535; the value is null for protocols without ports.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(securityGroupRuleToPort),
+			},
+			{
+				Name:        "source_port_range",
+				Description: "The range of source ports of the transport layer protocol, in the format start/end.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_cidr_ip",
+				Description: "The source IPv4 CIDR block for inbound access control.",
+				Type:        proto.ColumnType_INET,
+			},
+			{
+				Name:        "ipv6_source_cidr_ip",
+				Description: "The source IPv6 CIDR block for inbound access control.",
+				Type:        proto.ColumnType_INET,
+				Transform:   transform.FromField("Ipv6SourceCidrIp").NullIfZero(),
+			},
+			{
+				Name:        "dest_cidr_ip",
+				Description: "The destination IPv4 CIDR block for outbound access control.",
+				Type:        proto.ColumnType_INET,
+			},
+			{
+				Name:        "ipv6_dest_cidr_ip",
+				Description: "The destination IPv6 CIDR block for outbound access control.",
+				Type:        proto.ColumnType_INET,
+				Transform:   transform.FromField("Ipv6DestCidrIp").NullIfZero(),
+			},
+			{
+				Name:        "source_group_id",
+				Description: "The ID of the source security group for inbound access control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_group_name",
+				Description: "The name of the source security group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_group_owner_account",
+				Description: "The Alibaba Cloud account that manages the source security group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dest_group_id",
+				Description: "The ID of the destination security group for outbound access control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dest_group_name",
+				Description: "The name of the destination security group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dest_group_owner_account",
+				Description: "The Alibaba Cloud account that manages the destination security group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_prefix_list_id",
+				Description: "The ID of the source prefix list for inbound access control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_prefix_list_name",
+				Description: "The name of the source prefix list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dest_prefix_list_id",
+				Description: "The ID of the destination prefix list for outbound access control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dest_prefix_list_name",
+				Description: "The name of the destination prefix list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy",
+				Description: "The action of the rule. Possible values are: accept and drop.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the rule. A smaller value indicates a higher priority. Valid values: 1 to 100.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Priority").Transform(securityGroupRulePriority),
+			},
+			{
+				Name:        "nic_type",
+				Description: "The network type of the rule. Possible values are: internet and intranet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when the rule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(ecsSecurityGroupRuleTitle),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+type securityGroupRuleItem struct {
+	ecs.Permission
+	SecurityGroupId   string
+	SecurityGroupName string
+	VpcId             string
+	Region            string
+}
+
+//// LIST FUNCTION
+
+func listEcsSecurityGroupRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	securityGroup := h.Item.(ecs.SecurityGroup)
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	// Create service connection
+	client, err := ECSService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ecs_security_group_rule.listEcsSecurityGroupRules", "connection_error", err)
+		return nil, err
+	}
+
+	request := ecs.CreateDescribeSecurityGroupAttributeRequest()
+	request.Scheme = "https"
+	request.SecurityGroupId = securityGroup.SecurityGroupId
+	request.MaxResults = requests.NewInteger(1000)
+
+	pageLeft := true
+	for pageLeft {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.DescribeSecurityGroupAttribute(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_ecs_security_group_rule.listEcsSecurityGroupRules", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, permission := range response.Permissions.Permission {
+			d.StreamListItem(ctx, securityGroupRuleItem{
+				Permission:        permission,
+				SecurityGroupId:   securityGroup.SecurityGroupId,
+				SecurityGroupName: securityGroup.SecurityGroupName,
+				VpcId:             securityGroup.VpcId,
+				Region:            region,
+			})
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.NextToken != "" {
+			request.NextToken = response.NextToken
+		} else {
+			pageLeft = false
+		}
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ecsSecurityGroupRuleTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(securityGroupRuleItem)
+
+	// Build resource title
+	title := data.SecurityGroupId + "_" + data.Direction + "_" + data.IpProtocol + "_" + data.PortRange
+	if len(data.SecurityGroupRuleId) > 0 {
+		title = data.SecurityGroupRuleId
+	}
+
+	return title, nil
+}
+
+func securityGroupRuleFromPort(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(securityGroupRuleItem)
+	from, _, ok := parseSecurityGroupPortRange(data.IpProtocol, data.PortRange)
+	if !ok {
+		return nil, nil
+	}
+	return from, nil
+}
+
+func securityGroupRuleToPort(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(securityGroupRuleItem)
+	_, to, ok := parseSecurityGroupPortRange(data.IpProtocol, data.PortRange)
+	if !ok {
+		return nil, nil
+	}
+	return to, nil
+}
+
+func securityGroupRulePriority(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	priority, err := strconv.Atoi(d.Value.(string))
+	if err != nil {
+		return nil, nil
+	}
+	return priority, nil
+}
+
+// parseSecurityGroupPortRange splits a port range of the form "start/end" into
+// its bounds. "-1/-1" means all ports for TCP, UDP and ALL, and is returned as
+// 1-65535; protocols without ports (ICMP, ICMPv6 and GRE) report no range.
+func parseSecurityGroupPortRange(protocol string, portRange string) (int, int, bool) {
+	switch strings.ToUpper(protocol) {
+	case "ICMP", "ICMPV6", "GRE":
+		return 0, 0, false
+	}
+
+	parts := strings.Split(portRange, "/")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	from, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	to, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	if from == -1 && to == -1 {
+		return 1, 65535, true
+	}
+	return from, to, true
+}
@@ -297,7 +297,7 @@ func getEcsSnapshotArn(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	arn := "arn:acs:ecs:" + region + ":" + accountID + ":snapshot/" + data.SnapshotId
+	arn := buildArn("ecs", region, accountID, "snapshot", data.SnapshotId)
 
 	return arn, nil
 }
@@ -158,5 +158,5 @@ func getZoneAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	return []string{"acs:ecs::" + accountID + ":zone/" + data.ZoneId}, nil
+	return []string{buildArn("ecs", "", accountID, "zone", data.ZoneId)}, nil
 }
@@ -191,7 +191,7 @@ func getLogProjectAkas(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:log:" + data.Region + ":" + accountID + ":project/" + data.Name}
+	akas := []string{buildArn("log", data.Region, accountID, "project", data.Name)}
 	return akas, nil
 }
 
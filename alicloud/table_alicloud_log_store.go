@@ -291,6 +291,6 @@ func getLogstoreAkas(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:log:" + data.Region + ":" + accountID + ":project/" + data.Project + "/logstore/" + data.Name}
+	akas := []string{buildArn("log", data.Region, accountID, "project", data.Project+"/logstore/"+data.Name)}
 	return akas, nil
 }
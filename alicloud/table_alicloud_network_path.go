@@ -0,0 +1,587 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudNetworkPath(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_network_path",
+		Description: "Evaluates whether traffic between two endpoints is allowed by the route tables, network ACLs and security groups along the path.",
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkPaths,
+			Tags:    map[string]string{"service": "ecs", "action": "DescribeSecurityGroupAttribute"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "source", Require: plugin.Required},
+				{Name: "destination", Require: plugin.Required},
+				{Name: "protocol", Require: plugin.Optional},
+				{Name: "port", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "source",
+				Description: "The source of the traffic. An ECS instance ID, an elastic network interface ID or a CIDR block.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination",
+				Description: "The destination of the traffic. An ECS instance ID, an elastic network interface ID or a CIDR block.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol of the traffic. Possible values are: tcp (default), udp, icmp, icmpv6, gre and all.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port",
+				Description: "The destination port of the traffic. Required for the tcp and udp protocols, and ignored for the other protocols.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "allowed",
+				Description: "True if every route, network ACL and security group along the path allows the traffic. Null if the path cannot be evaluated, such as between two regions.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "reason",
+				Description: "A human readable explanation of the decision.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deciding_step",
+				Description: "The step that decided the outcome. Possible values are: source_security_group_egress, source_network_acl_egress, route, destination_network_acl_ingress and destination_security_group_ingress.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deciding_component_id",
+				Description: "The ID of the security group, network ACL or route table that decided the outcome.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deciding_rule",
+				Description: "The security group rule, network ACL entry or route entry that decided the outcome, if any.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "evaluations",
+				Description: "The outcome of each step along the path, in evaluation order.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "source_type",
+				Description: "The type of the source. Possible values are: instance, network_interface and cidr.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SourceEndpoint.Type"),
+			},
+			{
+				Name:        "source_ip",
+				Description: "The IP address or CIDR block of the source.",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("SourceEndpoint.Prefix").Transform(transform.ToString),
+			},
+			{
+				Name:        "source_vpc_id",
+				Description: "The ID of the VPC of the source.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SourceEndpoint.VpcId"),
+			},
+			{
+				Name:        "source_vswitch_id",
+				Description: "The ID of the vSwitch of the source.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SourceEndpoint.VSwitchId"),
+			},
+			{
+				Name:        "source_security_group_ids",
+				Description: "The IDs of the security groups of the source.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SourceEndpoint.SecurityGroupIds"),
+			},
+			{
+				Name:        "destination_type",
+				Description: "The type of the destination. Possible values are: instance, network_interface and cidr.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DestinationEndpoint.Type"),
+			},
+			{
+				Name:        "destination_ip",
+				Description: "The IP address or CIDR block of the destination.",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("DestinationEndpoint.Prefix").Transform(transform.ToString),
+			},
+			{
+				Name:        "destination_vpc_id",
+				Description: "The ID of the VPC of the destination.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DestinationEndpoint.VpcId"),
+			},
+			{
+				Name:        "destination_vswitch_id",
+				Description: "The ID of the vSwitch of the destination.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DestinationEndpoint.VSwitchId"),
+			},
+			{
+				Name:        "destination_security_group_ids",
+				Description: "The IDs of the security groups of the destination.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DestinationEndpoint.SecurityGroupIds"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(networkPathTitle),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+type networkPathRow struct {
+	Source              string
+	Destination         string
+	Protocol            string
+	Port                *int
+	Allowed             *bool
+	Reason              string
+	DecidingStep        string
+	DecidingComponentId string
+	DecidingRule        interface{}
+	Evaluations         []networkPathEvaluation
+	SourceEndpoint      *networkPathEndpoint
+	DestinationEndpoint *networkPathEndpoint
+	Region              string
+}
+
+//// LIST FUNCTION
+
+func listNetworkPaths(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	source := d.EqualsQualString("source")
+	destination := d.EqualsQualString("destination")
+
+	// The protocol is returned as given in the qual, so that the row matches it
+	row := networkPathRow{
+		Source:      source,
+		Destination: destination,
+		Protocol:    d.EqualsQualString("protocol"),
+		Region:      region,
+	}
+	if row.Protocol == "" {
+		row.Protocol = "tcp"
+	}
+	// The port is returned as given in the qual too, it is ignored by the
+	// protocols without ports
+	if d.EqualsQuals["port"] != nil {
+		port := int(d.EqualsQuals["port"].GetInt64Value())
+		row.Port = &port
+	}
+	protocol := strings.ToLower(row.Protocol)
+	flow := networkPathFlow{Protocol: protocol}
+	switch protocol {
+	case "tcp", "udp":
+		if row.Port == nil {
+			return nil, fmt.Errorf("port must be specified for the %s protocol", protocol)
+		}
+		flow.Port = *row.Port
+	case "icmp", "icmpv6", "gre", "all":
+	default:
+		return nil, fmt.Errorf("unsupported protocol %q, valid values are: tcp, udp, icmp, icmpv6, gre and all", protocol)
+	}
+
+	ecsClient, err := ECSService(ctx, d)
+	if err != nil {
+		logger.Error("alicloud_network_path.listNetworkPaths", "connection_error", err)
+		return nil, err
+	}
+	vpcClient, err := VpcService(ctx, d)
+	if err != nil {
+		logger.Error("alicloud_network_path.listNetworkPaths", "connection_error", err)
+		return nil, err
+	}
+
+	sourceEndpoint, err := resolveNetworkPathEndpoint(ctx, d, ecsClient, source)
+	if err != nil {
+		logger.Error("alicloud_network_path.listNetworkPaths", "source_error", err, "source", source)
+		return nil, err
+	}
+	destinationEndpoint, err := resolveNetworkPathEndpoint(ctx, d, ecsClient, destination)
+	if err != nil {
+		logger.Error("alicloud_network_path.listNetworkPaths", "destination_error", err, "destination", destination)
+		return nil, err
+	}
+
+	// Resources that don't exist in this region are evaluated in their own
+	// region. Paths between regions are not evaluated, they are reported in
+	// the region of the instance or ENI that was found.
+	if sourceEndpoint == nil || destinationEndpoint == nil {
+		found, missing := sourceEndpoint, source
+		if destinationEndpoint != nil {
+			found = destinationEndpoint
+		} else {
+			missing = destination
+		}
+		if found == nil || found.Type == "cidr" {
+			return nil, nil
+		}
+		row.SourceEndpoint = sourceEndpoint
+		row.DestinationEndpoint = destinationEndpoint
+		row.Reason = fmt.Sprintf("%s is not found in region %s; paths between regions are not evaluated", missing, region)
+		d.StreamListItem(ctx, row)
+		return nil, nil
+	}
+	if sourceEndpoint.Type == "cidr" && destinationEndpoint.Type == "cidr" {
+		return nil, fmt.Errorf("at least one of source or destination must be an ECS instance or elastic network interface")
+	}
+	if sourceEndpoint.Prefix.Addr().Is4() != destinationEndpoint.Prefix.Addr().Is4() {
+		return nil, fmt.Errorf("source and destination must use the same IP version")
+	}
+	row.SourceEndpoint = sourceEndpoint
+	row.DestinationEndpoint = destinationEndpoint
+
+	sameVSwitch := sourceEndpoint.VSwitchId != "" && sourceEndpoint.VSwitchId == destinationEndpoint.VSwitchId
+
+	// 1. Security groups of the source, outbound
+	if sourceEndpoint.Type != "cidr" {
+		groups, err := getNetworkPathSecurityGroups(ctx, d, ecsClient, sourceEndpoint.SecurityGroupIds)
+		if err != nil {
+			logger.Error("alicloud_network_path.listNetworkPaths", "security_group_error", err)
+			return nil, err
+		}
+		row.Evaluations = append(row.Evaluations, evaluateSecurityGroups("source_security_group_egress", "egress", groups, *destinationEndpoint, flow))
+	}
+
+	// 2. Network ACL of the source vSwitch, outbound
+	if sourceEndpoint.Type != "cidr" {
+		evaluation, err := evaluateNetworkPathNetworkAcl(ctx, d, vpcClient, "source_network_acl_egress", sourceEndpoint, *destinationEndpoint, flow, sameVSwitch)
+		if err != nil {
+			logger.Error("alicloud_network_path.listNetworkPaths", "network_acl_error", err)
+			return nil, err
+		}
+		row.Evaluations = append(row.Evaluations, evaluation)
+	}
+
+	// 3. Route table of the source vSwitch
+	if sourceEndpoint.Type != "cidr" {
+		evaluation, err := evaluateNetworkPathRoute(ctx, d, vpcClient, sourceEndpoint, destinationEndpoint)
+		if err != nil {
+			logger.Error("alicloud_network_path.listNetworkPaths", "route_error", err)
+			return nil, err
+		}
+		row.Evaluations = append(row.Evaluations, evaluation)
+	}
+
+	// 4. Network ACL of the destination vSwitch, inbound
+	if destinationEndpoint.Type != "cidr" {
+		evaluation, err := evaluateNetworkPathNetworkAcl(ctx, d, vpcClient, "destination_network_acl_ingress", destinationEndpoint, *sourceEndpoint, flow, sameVSwitch)
+		if err != nil {
+			logger.Error("alicloud_network_path.listNetworkPaths", "network_acl_error", err)
+			return nil, err
+		}
+		row.Evaluations = append(row.Evaluations, evaluation)
+	}
+
+	// 5. Security groups of the destination, inbound
+	if destinationEndpoint.Type != "cidr" {
+		groups, err := getNetworkPathSecurityGroups(ctx, d, ecsClient, destinationEndpoint.SecurityGroupIds)
+		if err != nil {
+			logger.Error("alicloud_network_path.listNetworkPaths", "security_group_error", err)
+			return nil, err
+		}
+		row.Evaluations = append(row.Evaluations, evaluateSecurityGroups("destination_security_group_ingress", "ingress", groups, *sourceEndpoint, flow))
+	}
+
+	allowed, decisive := networkPathDecide(row.Evaluations)
+	row.Allowed = &allowed
+	if decisive != nil {
+		row.Reason = decisive.Reason
+		row.DecidingStep = decisive.Step
+		row.DecidingComponentId = decisive.ComponentId
+		row.DecidingRule = decisive.Rule
+	}
+
+	d.StreamListItem(ctx, row)
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// resolveNetworkPathEndpoint turns an instance ID, ENI ID or CIDR block into an
+// endpoint. It returns nil if the instance or ENI doesn't exist in the region.
+func resolveNetworkPathEndpoint(ctx context.Context, d *plugin.QueryData, client *ecs.Client, value string) (*networkPathEndpoint, error) {
+	switch {
+	case strings.HasPrefix(value, "i-"):
+		request := ecs.CreateDescribeInstancesRequest()
+		request.Scheme = "https"
+		request.InstanceIds = fmt.Sprintf("[\"%s\"]", value)
+
+		d.WaitForListRateLimit(ctx)
+		response, err := client.DescribeInstances(request)
+		if err != nil {
+			return nil, err
+		}
+		if len(response.Instances.Instance) == 0 {
+			return nil, nil
+		}
+		instance := response.Instances.Instance[0]
+		if len(instance.VpcAttributes.PrivateIpAddress.IpAddress) == 0 {
+			return nil, fmt.Errorf("instance %s has no private IP address in a VPC", value)
+		}
+		prefix, err := parseNetworkPathPrefix(instance.VpcAttributes.PrivateIpAddress.IpAddress[0])
+		if err != nil {
+			return nil, err
+		}
+		return &networkPathEndpoint{
+			Type:             "instance",
+			ResourceId:       instance.InstanceId,
+			Prefix:           prefix,
+			VpcId:            instance.VpcAttributes.VpcId,
+			VSwitchId:        instance.VpcAttributes.VSwitchId,
+			SecurityGroupIds: instance.SecurityGroupIds.SecurityGroupId,
+		}, nil
+
+	case strings.HasPrefix(value, "eni-"):
+		request := ecs.CreateDescribeNetworkInterfacesRequest()
+		request.Scheme = "https"
+		request.NetworkInterfaceId = &[]string{value}
+
+		d.WaitForListRateLimit(ctx)
+		response, err := client.DescribeNetworkInterfaces(request)
+		if err != nil {
+			return nil, err
+		}
+		if len(response.NetworkInterfaceSets.NetworkInterfaceSet) == 0 {
+			return nil, nil
+		}
+		eni := response.NetworkInterfaceSets.NetworkInterfaceSet[0]
+		prefix, err := parseNetworkPathPrefix(eni.PrivateIpAddress)
+		if err != nil {
+			return nil, err
+		}
+		return &networkPathEndpoint{
+			Type:             "network_interface",
+			ResourceId:       eni.NetworkInterfaceId,
+			Prefix:           prefix,
+			VpcId:            eni.VpcId,
+			VSwitchId:        eni.VSwitchId,
+			SecurityGroupIds: eni.SecurityGroupIds.SecurityGroupId,
+		}, nil
+
+	default:
+		prefix, err := parseNetworkPathPrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an ECS instance ID, elastic network interface ID or CIDR block", value)
+		}
+		return &networkPathEndpoint{
+			Type:   "cidr",
+			Prefix: prefix,
+		}, nil
+	}
+}
+
+func getNetworkPathSecurityGroups(ctx context.Context, d *plugin.QueryData, client *ecs.Client, ids []string) ([]securityGroupRuleSet, error) {
+	var groups []securityGroupRuleSet
+	prefixLists := map[string][]string{}
+	for _, id := range ids {
+		group := securityGroupRuleSet{SecurityGroupId: id, PrefixLists: prefixLists}
+
+		request := ecs.CreateDescribeSecurityGroupAttributeRequest()
+		request.Scheme = "https"
+		request.SecurityGroupId = id
+		request.MaxResults = requests.NewInteger(1000)
+
+		pageLeft := true
+		for pageLeft {
+			d.WaitForListRateLimit(ctx)
+			response, err := client.DescribeSecurityGroupAttribute(request)
+			if err != nil {
+				return nil, err
+			}
+			group.InnerAccessPolicy = response.InnerAccessPolicy
+			group.Permissions = append(group.Permissions, response.Permissions.Permission...)
+			if response.NextToken != "" {
+				request.NextToken = response.NextToken
+			} else {
+				pageLeft = false
+			}
+		}
+
+		// Resolve the prefix lists referenced by the rules, once per list
+		for _, permission := range group.Permissions {
+			for _, prefixListId := range []string{permission.SourcePrefixListId, permission.DestPrefixListId} {
+				if _, ok := prefixLists[prefixListId]; prefixListId == "" || ok {
+					continue
+				}
+				cidrs, err := getNetworkPathPrefixListCidrs(ctx, d, client, prefixListId)
+				if err != nil {
+					return nil, err
+				}
+				prefixLists[prefixListId] = cidrs
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+func getNetworkPathPrefixListCidrs(ctx context.Context, d *plugin.QueryData, client *ecs.Client, prefixListId string) ([]string, error) {
+	request := ecs.CreateDescribePrefixListAttributesRequest()
+	request.Scheme = "https"
+	request.PrefixListId = prefixListId
+
+	d.WaitForListRateLimit(ctx)
+	response, err := client.DescribePrefixListAttributes(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var cidrs []string
+	for _, entry := range response.Entries.Entry {
+		cidrs = append(cidrs, entry.Cidr)
+	}
+	return cidrs, nil
+}
+
+func getNetworkPathVSwitch(ctx context.Context, d *plugin.QueryData, client *vpc.Client, vswitchId string) (*vpc.DescribeVSwitchAttributesResponse, error) {
+	request := vpc.CreateDescribeVSwitchAttributesRequest()
+	request.Scheme = "https"
+	request.VSwitchId = vswitchId
+
+	d.WaitForListRateLimit(ctx)
+	return client.DescribeVSwitchAttributes(request)
+}
+
+func evaluateNetworkPathNetworkAcl(ctx context.Context, d *plugin.QueryData, client *vpc.Client, step string, endpoint *networkPathEndpoint, peer networkPathEndpoint, flow networkPathFlow, sameVSwitch bool) (networkPathEvaluation, error) {
+	evaluation := networkPathEvaluation{Step: step, Decision: networkPathDecisionNotApplicable}
+
+	if sameVSwitch {
+		evaluation.Reason = "network ACLs don't filter traffic within the same vSwitch"
+		return evaluation, nil
+	}
+
+	vswitch, err := getNetworkPathVSwitch(ctx, d, client, endpoint.VSwitchId)
+	if err != nil {
+		return evaluation, err
+	}
+	if vswitch.NetworkAclId == "" {
+		evaluation.Reason = fmt.Sprintf("vSwitch %s has no network ACL", endpoint.VSwitchId)
+		return evaluation, nil
+	}
+
+	request := vpc.CreateDescribeNetworkAclAttributesRequest()
+	request.Scheme = "https"
+	request.NetworkAclId = vswitch.NetworkAclId
+
+	d.WaitForListRateLimit(ctx)
+	response, err := client.DescribeNetworkAclAttributes(request)
+	if err != nil {
+		return evaluation, err
+	}
+
+	acl := response.NetworkAclAttribute
+	if step == "source_network_acl_egress" {
+		return evaluateNetworkAclEgress(step, acl.NetworkAclId, acl.EgressAclEntries.EgressAclEntry, peer, flow), nil
+	}
+	return evaluateNetworkAclIngress(step, acl.NetworkAclId, acl.IngressAclEntries.IngressAclEntry, peer, flow), nil
+}
+
+func evaluateNetworkPathRoute(ctx context.Context, d *plugin.QueryData, client *vpc.Client, endpoint *networkPathEndpoint, destination *networkPathEndpoint) (networkPathEvaluation, error) {
+	evaluation := networkPathEvaluation{Step: "route"}
+
+	vswitch, err := getNetworkPathVSwitch(ctx, d, client, endpoint.VSwitchId)
+	if err != nil {
+		return evaluation, err
+	}
+
+	vpcRequest := vpc.CreateDescribeVpcAttributeRequest()
+	vpcRequest.Scheme = "https"
+	vpcRequest.VpcId = endpoint.VpcId
+
+	d.WaitForListRateLimit(ctx)
+	vpcResponse, err := client.DescribeVpcAttribute(vpcRequest)
+	if err != nil {
+		return evaluation, err
+	}
+	vpcCidrs := append([]string{vpcResponse.CidrBlock}, vpcResponse.SecondaryCidrBlocks.SecondaryCidrBlock...)
+	if vpcResponse.Ipv6CidrBlock != "" {
+		vpcCidrs = append(vpcCidrs, vpcResponse.Ipv6CidrBlock)
+	}
+
+	routeTableId := vswitch.RouteTable.RouteTableId
+	var entries []vpc.RouteEntry
+	if routeTableId != "" {
+		request := vpc.CreateDescribeRouteEntryListRequest()
+		request.Scheme = "https"
+		request.RouteTableId = routeTableId
+		request.MaxResult = requests.NewInteger(100)
+
+		pageLeft := true
+		for pageLeft {
+			d.WaitForListRateLimit(ctx)
+			response, err := client.DescribeRouteEntryList(request)
+			if err != nil {
+				return evaluation, err
+			}
+			entries = append(entries, response.RouteEntrys.RouteEntry...)
+			if response.NextToken != "" {
+				request.NextToken = response.NextToken
+			} else {
+				pageLeft = false
+			}
+		}
+	}
+
+	// Destinations given as a CIDR block have no VPC, they are routed like
+	// any other address
+	destinationVpcId := ""
+	if destination.VpcId != "" && destination.VpcId != endpoint.VpcId {
+		destinationVpcId = destination.VpcId
+	}
+	return evaluateRoute("route", routeTableId, vpcCidrs, entries, destination.Prefix, destinationVpcId), nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func networkPathTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	row := d.HydrateItem.(networkPathRow)
+
+	title := row.Source + " -> " + row.Destination + " (" + row.Protocol
+	if row.Port != nil {
+		title += fmt.Sprintf("/%d", *row.Port)
+	}
+	return title + ")", nil
+}
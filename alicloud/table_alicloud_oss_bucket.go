@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/aliyun/alibabacloud-oss-go-sdk-v2/oss"
@@ -42,6 +43,46 @@ func tableAlicloudOssBucket(ctx context.Context) *plugin.Table {
 				Func: getBucketPolicy,
 				Tags: map[string]string{"service": "oss", "action": "GetBucketPolicy"},
 			},
+			{
+				Func: getBucketCors,
+				Tags: map[string]string{"service": "oss", "action": "GetBucketCors"},
+			},
+			{
+				Func: getBucketReferer,
+				Tags: map[string]string{"service": "oss", "action": "GetBucketReferer"},
+			},
+			{
+				Func: getBucketWebsite,
+				Tags: map[string]string{"service": "oss", "action": "GetBucketWebsite"},
+			},
+			{
+				Func: getBucketReplication,
+				Tags: map[string]string{"service": "oss", "action": "GetBucketReplication"},
+			},
+			{
+				Func: listBucketInventory,
+				Tags: map[string]string{"service": "oss", "action": "ListBucketInventory"},
+			},
+			{
+				Func: getBucketWorm,
+				Tags: map[string]string{"service": "oss", "action": "GetBucketWorm"},
+			},
+			{
+				Func: getBucketTransferAcceleration,
+				Tags: map[string]string{"service": "oss", "action": "GetBucketTransferAcceleration"},
+			},
+			{
+				Func: getBucketAccessMonitor,
+				Tags: map[string]string{"service": "oss", "action": "GetBucketAccessMonitor"},
+			},
+			{
+				Func: getBucketEncryption,
+				Tags: map[string]string{"service": "oss", "action": "GetBucketEncryption"},
+			},
+			{
+				Func: getBucketStat,
+				Tags: map[string]string{"service": "oss", "action": "GetBucketStat"},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -53,7 +94,8 @@ func tableAlicloudOssBucket(ctx context.Context) *plugin.Table {
 				Name:        "arn",
 				Description: "The Alibaba Cloud Resource Name (ARN) of the OSS bucket.",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.From(bucketARN),
+				Hydrate:     getOssBucketARN,
+				Transform:   transform.FromValue(),
 			},
 			{
 				Name:        "location",
@@ -98,6 +140,139 @@ func tableAlicloudOssBucket(ctx context.Context) *plugin.Table {
 				Transform:   transform.FromField("BucketInfo.SseRule").Transform(bucketSSEConfiguration),
 				Description: "The server-side encryption configuration for bucket",
 			},
+			{
+				Name:        "sse_algorithm",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBucketEncryption,
+				Transform:   transform.FromField("ServerSideEncryptionRule.ApplyServerSideEncryptionByDefault.SSEAlgorithm"),
+				Description: "The default server-side encryption method of the bucket. Valid values: KMS, AES256, and SM4.",
+			},
+			{
+				Name:        "kms_master_key_id",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBucketEncryption,
+				Transform:   transform.FromField("ServerSideEncryptionRule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID"),
+				Description: "The ID of the KMS key used for default server-side encryption, if SSEAlgorithm is set to KMS and a specified key is used.",
+			},
+			{
+				Name:        "kms_data_encryption",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBucketEncryption,
+				Transform:   transform.FromField("ServerSideEncryptionRule.ApplyServerSideEncryptionByDefault.KMSDataEncryption"),
+				Description: "The algorithm used to encrypt objects when SSEAlgorithm is set to KMS. Valid value: SM4.",
+			},
+			{
+				Name:        "transfer_acceleration_enabled",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getBucketTransferAcceleration,
+				Transform:   transform.FromField("TransferAccelerationConfiguration.Enabled"),
+				Description: "Indicates whether transfer acceleration is enabled for the bucket.",
+			},
+			{
+				Name:        "access_monitor_status",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBucketAccessMonitor,
+				Transform:   transform.FromField("AccessMonitorConfiguration.Status"),
+				Description: "The access tracking status of the bucket. Valid values: Enabled and Disabled.",
+			},
+			{
+				Name:        "object_count",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("ObjectCount"),
+				Description: "The total number of objects that are stored in the bucket.",
+			},
+			{
+				Name:        "storage",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("Storage"),
+				Description: "The storage capacity of the bucket, in bytes.",
+			},
+			{
+				Name:        "multipart_upload_count",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("MultipartUploadCount"),
+				Description: "The number of multipart upload tasks that have been initiated but are not completed or canceled.",
+			},
+			{
+				Name:        "standard_storage",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("StandardStorage"),
+				Description: "The storage usage of Standard objects in the bucket, in bytes.",
+			},
+			{
+				Name:        "standard_object_count",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("StandardObjectCount"),
+				Description: "The number of Standard objects in the bucket.",
+			},
+			{
+				Name:        "infrequent_access_storage",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("InfrequentAccessStorage"),
+				Description: "The billed storage usage of Infrequent Access (IA) objects in the bucket, in bytes.",
+			},
+			{
+				Name:        "infrequent_access_object_count",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("InfrequentAccessObjectCount"),
+				Description: "The number of Infrequent Access (IA) objects in the bucket.",
+			},
+			{
+				Name:        "archive_storage",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("ArchiveStorage"),
+				Description: "The billed storage usage of Archive objects in the bucket, in bytes.",
+			},
+			{
+				Name:        "archive_object_count",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("ArchiveObjectCount"),
+				Description: "The number of Archive objects in the bucket.",
+			},
+			{
+				Name:        "cold_archive_storage",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("ColdArchiveStorage"),
+				Description: "The billed storage usage of Cold Archive objects in the bucket, in bytes.",
+			},
+			{
+				Name:        "cold_archive_object_count",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("ColdArchiveObjectCount"),
+				Description: "The number of Cold Archive objects in the bucket.",
+			},
+			{
+				Name:        "deep_cold_archive_storage",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("DeepColdArchiveStorage"),
+				Description: "The billed storage usage of Deep Cold Archive objects in the bucket, in bytes.",
+			},
+			{
+				Name:        "deep_cold_archive_object_count",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("DeepColdArchiveObjectCount"),
+				Description: "The number of Deep Cold Archive objects in the bucket.",
+			},
+			{
+				Name:        "stats_last_modified_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getBucketStat,
+				Transform:   transform.FromField("LastModifiedTime").Transform(transform.UnixToTimestamp),
+				Description: "The time when the bucket statistics were last updated.",
+			},
 			{
 				Name:        "lifecycle_rules",
 				Type:        proto.ColumnType_JSON,
@@ -112,6 +287,48 @@ func tableAlicloudOssBucket(ctx context.Context) *plugin.Table {
 				Transform:   transform.FromField("LoggingEnabled"),
 				Description: "Indicates the container used to store access logging configuration of a bucket.",
 			},
+			{
+				Name:        "cors_rules",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketCors,
+				Transform:   transform.FromField("CORSConfiguration.CORSRules"),
+				Description: "The cross-origin resource sharing (CORS) rules configured for the bucket.",
+			},
+			{
+				Name:        "referer_configuration",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketReferer,
+				Transform:   transform.FromField("RefererConfiguration"),
+				Description: "The hotlink protection configuration of the bucket, including the Referer whitelist and blacklist.",
+			},
+			{
+				Name:        "website",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketWebsite,
+				Transform:   transform.FromField("WebsiteConfiguration"),
+				Description: "The static website hosting configuration of the bucket, including the default homepage, error page and redirection rules.",
+			},
+			{
+				Name:        "replication_rules",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketReplication,
+				Transform:   transform.FromField("ReplicationConfiguration.Rules"),
+				Description: "The cross-region replication rules configured for the bucket.",
+			},
+			{
+				Name:        "inventory_configurations",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listBucketInventory,
+				Transform:   transform.FromValue(),
+				Description: "The inventory configurations of the bucket.",
+			},
+			{
+				Name:        "worm_configuration",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketWorm,
+				Transform:   transform.FromField("WormConfiguration"),
+				Description: "The retention policy (WORM) configuration of the bucket.",
+			},
 			{
 				Name:        "policy",
 				Type:        proto.ColumnType_JSON,
@@ -144,7 +361,8 @@ func tableAlicloudOssBucket(ctx context.Context) *plugin.Table {
 			{
 				Name:        "akas",
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(bucketARN).Transform(transform.EnsureStringArray),
+				Hydrate:     getOssBucketARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
 				Description: ColumnDescriptionAkas,
 			},
 
@@ -318,6 +536,271 @@ func getBucketLifecycle(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	return response, nil
 }
 
+func getBucketCors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bucket := h.Item.(oss.BucketProperties)
+	client, err := OssService(ctx, d, removeSuffixFromLocation(*bucket.Location))
+	if err != nil {
+		logger.Error("getBucketCors", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.GetBucketCorsRequest{
+		Bucket: bucket.Name,
+	}
+
+	response, err := client.GetBucketCors(ctx, param)
+	if err != nil {
+		if isOssServiceErrorCode(err, "NoSuchCORSConfiguration") {
+			return nil, nil
+		}
+		logger.Error("getBucketCors", "query_error", err, "bucket", bucket.Name)
+		return nil, err
+	}
+	return response, nil
+}
+
+func getBucketReferer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bucket := h.Item.(oss.BucketProperties)
+	client, err := OssService(ctx, d, removeSuffixFromLocation(*bucket.Location))
+	if err != nil {
+		logger.Error("getBucketReferer", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.GetBucketRefererRequest{
+		Bucket: bucket.Name,
+	}
+
+	response, err := client.GetBucketReferer(ctx, param)
+	if err != nil {
+		logger.Error("getBucketReferer", "query_error", err, "bucket", bucket.Name)
+		return nil, err
+	}
+	return response, nil
+}
+
+func getBucketWebsite(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bucket := h.Item.(oss.BucketProperties)
+	client, err := OssService(ctx, d, removeSuffixFromLocation(*bucket.Location))
+	if err != nil {
+		logger.Error("getBucketWebsite", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.GetBucketWebsiteRequest{
+		Bucket: bucket.Name,
+	}
+
+	response, err := client.GetBucketWebsite(ctx, param)
+	if err != nil {
+		if isOssServiceErrorCode(err, "NoSuchWebsiteConfiguration") {
+			return nil, nil
+		}
+		logger.Error("getBucketWebsite", "query_error", err, "bucket", bucket.Name)
+		return nil, err
+	}
+	return response, nil
+}
+
+func getBucketReplication(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bucket := h.Item.(oss.BucketProperties)
+	client, err := OssService(ctx, d, removeSuffixFromLocation(*bucket.Location))
+	if err != nil {
+		logger.Error("getBucketReplication", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.GetBucketReplicationRequest{
+		Bucket: bucket.Name,
+	}
+
+	response, err := client.GetBucketReplication(ctx, param)
+	if err != nil {
+		if isOssServiceErrorCode(err, "NoSuchReplicationConfiguration", "NoSuchReplicationRule") {
+			return nil, nil
+		}
+		logger.Error("getBucketReplication", "query_error", err, "bucket", bucket.Name)
+		return nil, err
+	}
+	return response, nil
+}
+
+func listBucketInventory(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bucket := h.Item.(oss.BucketProperties)
+	client, err := OssService(ctx, d, removeSuffixFromLocation(*bucket.Location))
+	if err != nil {
+		logger.Error("listBucketInventory", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.ListBucketInventoryRequest{
+		Bucket: bucket.Name,
+	}
+
+	var inventories []oss.InventoryConfiguration
+	for {
+		response, err := client.ListBucketInventory(ctx, param)
+		if err != nil {
+			if isOssServiceErrorCode(err, "NoSuchInventory") {
+				return nil, nil
+			}
+			logger.Error("listBucketInventory", "query_error", err, "bucket", bucket.Name)
+			return nil, err
+		}
+
+		result := response.ListInventoryConfigurationsResult
+		if result == nil {
+			break
+		}
+		inventories = append(inventories, result.InventoryConfigurations...)
+
+		if result.IsTruncated == nil || !*result.IsTruncated || result.NextContinuationToken == nil {
+			break
+		}
+		param.ContinuationToken = result.NextContinuationToken
+	}
+
+	if len(inventories) == 0 {
+		return nil, nil
+	}
+	return inventories, nil
+}
+
+func getBucketWorm(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bucket := h.Item.(oss.BucketProperties)
+	client, err := OssService(ctx, d, removeSuffixFromLocation(*bucket.Location))
+	if err != nil {
+		logger.Error("getBucketWorm", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.GetBucketWormRequest{
+		Bucket: bucket.Name,
+	}
+
+	response, err := client.GetBucketWorm(ctx, param)
+	if err != nil {
+		if isOssServiceErrorCode(err, "NoSuchWORMConfiguration") {
+			return nil, nil
+		}
+		logger.Error("getBucketWorm", "query_error", err, "bucket", bucket.Name)
+		return nil, err
+	}
+	return response, nil
+}
+
+func getBucketTransferAcceleration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bucket := h.Item.(oss.BucketProperties)
+	client, err := OssService(ctx, d, removeSuffixFromLocation(*bucket.Location))
+	if err != nil {
+		logger.Error("getBucketTransferAcceleration", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.GetBucketTransferAccelerationRequest{
+		Bucket: bucket.Name,
+	}
+
+	response, err := client.GetBucketTransferAcceleration(ctx, param)
+	if err != nil {
+		if isOssServiceErrorCode(err, "NoSuchTransferAccelerationConfiguration") {
+			return nil, nil
+		}
+		logger.Error("getBucketTransferAcceleration", "query_error", err, "bucket", bucket.Name)
+		return nil, err
+	}
+	return response, nil
+}
+
+func getBucketAccessMonitor(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bucket := h.Item.(oss.BucketProperties)
+	client, err := OssService(ctx, d, removeSuffixFromLocation(*bucket.Location))
+	if err != nil {
+		logger.Error("getBucketAccessMonitor", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.GetBucketAccessMonitorRequest{
+		Bucket: bucket.Name,
+	}
+
+	response, err := client.GetBucketAccessMonitor(ctx, param)
+	if err != nil {
+		logger.Error("getBucketAccessMonitor", "query_error", err, "bucket", bucket.Name)
+		return nil, err
+	}
+	return response, nil
+}
+
+func getBucketEncryption(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bucket := h.Item.(oss.BucketProperties)
+	client, err := OssService(ctx, d, removeSuffixFromLocation(*bucket.Location))
+	if err != nil {
+		logger.Error("getBucketEncryption", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.GetBucketEncryptionRequest{
+		Bucket: bucket.Name,
+	}
+
+	response, err := client.GetBucketEncryption(ctx, param)
+	if err != nil {
+		if isOssServiceErrorCode(err, "NoSuchServerSideEncryptionRule") {
+			return nil, nil
+		}
+		logger.Error("getBucketEncryption", "query_error", err, "bucket", bucket.Name)
+		return nil, err
+	}
+	return response, nil
+}
+
+func getBucketStat(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bucket := h.Item.(oss.BucketProperties)
+	client, err := OssService(ctx, d, removeSuffixFromLocation(*bucket.Location))
+	if err != nil {
+		logger.Error("getBucketStat", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.GetBucketStatRequest{
+		Bucket: bucket.Name,
+	}
+
+	response, err := client.GetBucketStat(ctx, param)
+	if err != nil {
+		logger.Error("getBucketStat", "query_error", err, "bucket", bucket.Name)
+		return nil, err
+	}
+	return response, nil
+}
+
+func getOssBucketARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getOssBucketARN")
+	bucket := h.Item.(oss.BucketProperties)
+
+	// Get project details
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*alicloudCommonColumnData)
+	accountID := commonColumnData.AccountID
+
+	return buildArn("oss", removeSuffixFromLocation(*bucket.Location), accountID, "", *bucket.Name), nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func ossBucketTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -373,13 +856,6 @@ func bucketSSEConfiguration(_ context.Context, d *transform.TransformData) (inte
 	return result, nil
 }
 
-func bucketARN(ctx context.Context, d *transform.TransformData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("bucketARN")
-	bucket := d.HydrateItem.(oss.BucketProperties)
-
-	return "arn:acs:oss:::" + *bucket.Name, nil
-}
-
 func bucketRegion(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("bucketRegion")
 	bucket := d.HydrateItem.(oss.BucketProperties)
@@ -389,3 +865,18 @@ func bucketRegion(ctx context.Context, d *transform.TransformData) (interface{},
 func removeSuffixFromLocation(location string) string {
 	return strings.TrimPrefix(location, "oss-")
 }
+
+// isOssServiceErrorCode checks whether an OSS v2 SDK error, which is usually
+// wrapped in an oss.OperationError, carries one of the given service error codes
+func isOssServiceErrorCode(err error, codes ...string) bool {
+	var serviceErr *oss.ServiceError
+	if !errors.As(err, &serviceErr) {
+		return false
+	}
+	for _, code := range codes {
+		if serviceErr.Code == code {
+			return true
+		}
+	}
+	return false
+}
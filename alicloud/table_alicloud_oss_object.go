@@ -0,0 +1,380 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibabacloud-oss-go-sdk-v2/oss"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudOssObject(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_oss_object",
+		Description: "Object Storage Object",
+		List: &plugin.ListConfig{
+			Hydrate: listOssObjects,
+			Tags:    map[string]string{"service": "oss", "action": "ListObjectsV2"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket", Require: plugin.Required},
+				{Name: "prefix", Require: plugin.Optional},
+				{Name: "delimiter", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getOssObjectAcl,
+				Tags: map[string]string{"service": "oss", "action": "GetObjectAcl"},
+			},
+			{
+				Func: getOssObjectTagging,
+				Tags: map[string]string{"service": "oss", "action": "GetObjectTagging"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "key",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the object.",
+				Transform:   transform.FromField("Object.Key"),
+			},
+			{
+				Name:        "bucket",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the bucket that contains the object.",
+			},
+			{
+				Name:        "prefix",
+				Type:        proto.ColumnType_STRING,
+				Description: "The prefix used to filter the returned objects.",
+				Transform:   transform.FromQual("prefix"),
+			},
+			{
+				Name:        "delimiter",
+				Type:        proto.ColumnType_STRING,
+				Description: "The character used to group object names. Objects whose names contain the delimiter after the prefix are returned as a single common prefix row.",
+				Transform:   transform.FromQual("delimiter"),
+			},
+			{
+				Name:        "is_prefix",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the row is a common prefix, such as a folder, returned when a delimiter is set rather than an object.",
+				Transform:   transform.FromField("IsPrefix"),
+			},
+			{
+				Name:        "arn",
+				Type:        proto.ColumnType_STRING,
+				Description: "The Alibaba Cloud Resource Name (ARN) of the OSS object.",
+				Hydrate:     getOssObjectARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "size",
+				Type:        proto.ColumnType_INT,
+				Description: "The size of the object, in bytes.",
+				Transform:   transform.FromField("Object.Size"),
+			},
+			{
+				Name:        "etag",
+				Type:        proto.ColumnType_STRING,
+				Description: "The entity tag (ETag) of the object, used to identify the content of the object.",
+				Transform:   transform.FromField("Object.ETag"),
+			},
+			{
+				Name:        "storage_class",
+				Type:        proto.ColumnType_STRING,
+				Description: "The storage class of the object.",
+				Transform:   transform.FromField("Object.StorageClass"),
+			},
+			{
+				Name:        "last_modified",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The time when the object was last modified.",
+				Transform:   transform.FromField("Object.LastModified"),
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the object. Valid values: Normal, Multipart and Appendable.",
+				Transform:   transform.FromField("Object.Type"),
+			},
+			{
+				Name:        "owner_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the object owner.",
+				Transform:   transform.FromField("Object.Owner.ID"),
+			},
+			{
+				Name:        "owner_display_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the object owner.",
+				Transform:   transform.FromField("Object.Owner.DisplayName"),
+			},
+			{
+				Name:        "restore_info",
+				Type:        proto.ColumnType_STRING,
+				Description: "The restoration status of the object.",
+				Transform:   transform.FromField("Object.RestoreInfo"),
+			},
+			{
+				Name:        "transition_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The time when the storage class of the object was converted to Cold Archive or Deep Cold Archive based on lifecycle rules.",
+				Transform:   transform.FromField("Object.TransitionTime"),
+			},
+			{
+				Name:        "acl",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getOssObjectAcl,
+				Transform:   transform.FromField("ACL"),
+				Description: "The access control list setting for the object. Valid values: default, private, public-read and public-read-write. default indicates that the object inherits the ACL of the bucket.",
+			},
+			{
+				Name:        "tags_src",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getOssObjectTagging,
+				Transform:   transform.FromField("Tags").Transform(ossBucketTagsSrc),
+				Description: "A list of tags assigned to the object.",
+			},
+
+			// steampipe standard columns
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getOssObjectTagging,
+				Transform:   transform.FromField("Tags").Transform(ossBucketTags),
+				Description: ColumnDescriptionTags,
+			},
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Object.Key"),
+				Description: ColumnDescriptionTitle,
+			},
+			{
+				Name:        "akas",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getOssObjectARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+				Description: ColumnDescriptionAkas,
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+type ossObjectItem struct {
+	Bucket   string
+	Region   string
+	IsPrefix bool
+	Object   oss.ObjectProperties
+}
+
+//// LIST FUNCTION
+
+func listOssObjects(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bucket := d.EqualsQualString("bucket")
+	if bucket == "" {
+		return nil, nil
+	}
+
+	region, err := getOssBucketRegion(ctx, d, bucket)
+	if err != nil {
+		logger.Error("alicloud_oss_object.listOssObjects", "bucket_location_error", err, "bucket", bucket)
+		return nil, err
+	}
+
+	client, err := OssService(ctx, d, region)
+	if err != nil {
+		logger.Error("alicloud_oss_object.listOssObjects", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.ListObjectsV2Request{
+		Bucket:     oss.Ptr(bucket),
+		MaxKeys:    int32(1000),
+		FetchOwner: true,
+	}
+	if prefix := d.EqualsQualString("prefix"); prefix != "" {
+		param.Prefix = oss.Ptr(prefix)
+	}
+	if delimiter := d.EqualsQualString("delimiter"); delimiter != "" {
+		param.Delimiter = oss.Ptr(delimiter)
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// update limit to the requested no of results.
+	limit := d.QueryContext.Limit
+	if limit != nil && *limit < int64(param.MaxKeys) {
+		param.MaxKeys = int32(*limit)
+	}
+
+	page := client.NewListObjectsV2Paginator(param)
+
+	for page.HasNext() {
+		d.WaitForListRateLimit(ctx)
+		p, err := page.NextPage(ctx)
+		if err != nil {
+			logger.Error("alicloud_oss_object.listOssObjects", "paging_error", err, "bucket", bucket)
+			return nil, err
+		}
+
+		for _, object := range p.Contents {
+			d.StreamListItem(ctx, ossObjectItem{
+				Bucket: bucket,
+				Region: region,
+				Object: object,
+			})
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// The names grouped by the delimiter are returned as common prefixes
+		for _, commonPrefix := range p.CommonPrefixes {
+			d.StreamListItem(ctx, ossObjectItem{
+				Bucket:   bucket,
+				Region:   region,
+				IsPrefix: true,
+				Object:   oss.ObjectProperties{Key: commonPrefix.Prefix},
+			})
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getOssObjectAcl(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	item := h.Item.(ossObjectItem)
+
+	// Common prefixes are not objects
+	if item.IsPrefix {
+		return nil, nil
+	}
+
+	client, err := OssService(ctx, d, item.Region)
+	if err != nil {
+		logger.Error("alicloud_oss_object.getOssObjectAcl", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.GetObjectAclRequest{
+		Bucket: oss.Ptr(item.Bucket),
+		Key:    item.Object.Key,
+	}
+
+	response, err := client.GetObjectAcl(ctx, param)
+	if err != nil {
+		logger.Error("alicloud_oss_object.getOssObjectAcl", "query_error", err, "bucket", item.Bucket, "key", *item.Object.Key)
+		return nil, err
+	}
+	return response, nil
+}
+
+func getOssObjectTagging(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	item := h.Item.(ossObjectItem)
+
+	// Common prefixes are not objects
+	if item.IsPrefix {
+		return nil, nil
+	}
+
+	client, err := OssService(ctx, d, item.Region)
+	if err != nil {
+		logger.Error("alicloud_oss_object.getOssObjectTagging", "connection_error", err)
+		return nil, err
+	}
+
+	param := &oss.GetObjectTaggingRequest{
+		Bucket: oss.Ptr(item.Bucket),
+		Key:    item.Object.Key,
+	}
+
+	response, err := client.GetObjectTagging(ctx, param)
+	if err != nil {
+		logger.Error("alicloud_oss_object.getOssObjectTagging", "query_error", err, "bucket", item.Bucket, "key", *item.Object.Key)
+		return nil, err
+	}
+	return response, nil
+}
+
+// getOssBucketRegion returns the region of the bucket, resolved through the
+// default region endpoint since a bucket can only be listed from its own
+// region. The region is memoized per bucket for the connection.
+func getOssBucketRegion(ctx context.Context, d *plugin.QueryData, bucket string) (string, error) {
+	region, err := getOssBucketRegionMemoize(ctx, d, &plugin.HydrateData{Item: bucket})
+	if err != nil {
+		return "", err
+	}
+	return region.(string), nil
+}
+
+var getOssBucketRegionMemoize = plugin.HydrateFunc(getOssBucketRegionUncached).Memoize(memoize.WithCacheKeyFunction(getOssBucketRegionCacheKey))
+
+func getOssBucketRegionCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cacheKey := "GetBucketLocation-" + h.Item.(string)
+	return cacheKey, nil
+}
+
+func getOssBucketRegionUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(string)
+
+	client, err := OssService(ctx, d, GetDefaultRegion(d.Connection))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.GetBucketLocation(ctx, &oss.GetBucketLocationRequest{
+		Bucket: oss.Ptr(bucket),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return removeSuffixFromLocation(oss.ToString(response.LocationConstraint)), nil
+}
+
+func getOssObjectARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getOssObjectARN")
+	item := h.Item.(ossObjectItem)
+
+	// Get project details
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*alicloudCommonColumnData)
+	accountID := commonColumnData.AccountID
+
+	return buildArn("oss", item.Region, accountID, "", item.Bucket+"/"+*item.Object.Key), nil
+}
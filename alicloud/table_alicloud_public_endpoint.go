@@ -0,0 +1,615 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const (
+	publicEndpointTypeEcsInstance       = "ecs_instance"
+	publicEndpointTypeEip               = "eip"
+	publicEndpointTypeSlbLoadBalancer   = "slb_load_balancer"
+	publicEndpointTypeRdsInstance       = "rds_instance"
+	publicEndpointTypeKubernetesCluster = "cs_kubernetes_cluster"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudPublicEndpoint(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_public_endpoint",
+		Description: "Internet-facing endpoints of ECS instances, EIPs, SLB load balancers, RDS instances and ACK clusters.",
+		List: &plugin.ListConfig{
+			Hydrate: listPublicEndpoints,
+			Tags:    map[string]string{"service": "ecs", "action": "DescribeInstances"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "resource_type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "resource_type",
+				Description: "The type of the exposed resource. Possible values are: ecs_instance, eip, slb_load_balancer, rds_instance and cs_kubernetes_cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the exposed resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name or description of the exposed resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "public_ip",
+				Description: "The public IP address of the endpoint.",
+				Type:        proto.ColumnType_INET,
+				Transform:   transform.FromField("PublicIp").NullIfZero(),
+			},
+			{
+				Name:        "public_dns",
+				Description: "The public DNS name of the endpoint, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "open_ports",
+				Description: "The ports reachable from the internet. Derived from security group rules for ECS instances and EIPs, from listeners for SLB load balancers, and from the connection port for RDS instances and ACK clusters.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "security_group_ids",
+				Description: "The IDs of the security groups used to derive the open ports.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "associated_resource_type",
+				Description: "The type of the resource the endpoint is bound to, for EIPs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "associated_resource_id",
+				Description: "The ID of the resource the endpoint is bound to, for EIPs.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(publicEndpointTitle),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+type publicEndpointRow struct {
+	ResourceType           string
+	ResourceId             string
+	ResourceName           string
+	PublicIp               string
+	PublicDns              string
+	OpenPorts              []publicEndpointPort
+	SecurityGroupIds       []string
+	AssociatedResourceType string
+	AssociatedResourceId   string
+	Region                 string
+}
+
+type publicEndpointPort struct {
+	Protocol string
+	FromPort *int
+	ToPort   *int
+}
+
+//// LIST FUNCTION
+
+func listPublicEndpoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	resourceType := d.EqualsQualString("resource_type")
+
+	// The rules of the security groups are read once per query of a region,
+	// as the instances and ENIs usually share a few groups
+	groups := securityGroupCache{}
+
+	sources := []struct {
+		resourceType string
+		list         func(context.Context, *plugin.QueryData, securityGroupCache) error
+	}{
+		{publicEndpointTypeEcsInstance, listPublicEcsInstanceEndpoints},
+		{publicEndpointTypeEip, listPublicEipEndpoints},
+		{publicEndpointTypeSlbLoadBalancer, listPublicSlbEndpoints},
+		{publicEndpointTypeRdsInstance, listPublicRdsEndpoints},
+		{publicEndpointTypeKubernetesCluster, listPublicKubernetesEndpoints},
+	}
+
+	for _, source := range sources {
+		if resourceType != "" && resourceType != source.resourceType {
+			continue
+		}
+		if err := source.list(ctx, d, groups); err != nil {
+			return nil, err
+		}
+		// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func listPublicEcsInstanceEndpoints(ctx context.Context, d *plugin.QueryData, groups securityGroupCache) error {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	client, err := ECSService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicEcsInstanceEndpoints", "connection_error", err)
+		return err
+	}
+
+	request := ecs.CreateDescribeInstancesRequest()
+	request.Scheme = "https"
+	request.MaxResults = requests.NewInteger(100)
+	request.RegionId = region
+
+	pageLeft := true
+	for pageLeft {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.DescribeInstances(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicEcsInstanceEndpoints", "query_error", err, "request", request)
+			return err
+		}
+		for _, instance := range response.Instances.Instance {
+			if len(instance.PublicIpAddress.IpAddress) == 0 {
+				continue
+			}
+			openPorts, err := getSecurityGroupOpenPorts(ctx, d, client, groups, instance.SecurityGroupIds.SecurityGroupId)
+			if err != nil {
+				plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicEcsInstanceEndpoints", "security_group_error", err, "instance", instance.InstanceId)
+				return err
+			}
+			for _, ip := range instance.PublicIpAddress.IpAddress {
+				d.StreamListItem(ctx, publicEndpointRow{
+					ResourceType:     publicEndpointTypeEcsInstance,
+					ResourceId:       instance.InstanceId,
+					ResourceName:     instance.InstanceName,
+					PublicIp:         ip,
+					OpenPorts:        openPorts,
+					SecurityGroupIds: instance.SecurityGroupIds.SecurityGroupId,
+					Region:           region,
+				})
+				if d.RowsRemaining(ctx) == 0 {
+					return nil
+				}
+			}
+		}
+		if response.NextToken != "" {
+			request.NextToken = response.NextToken
+		} else {
+			pageLeft = false
+		}
+	}
+	return nil
+}
+
+func listPublicEipEndpoints(ctx context.Context, d *plugin.QueryData, groups securityGroupCache) error {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	client, err := VpcService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicEipEndpoints", "connection_error", err)
+		return err
+	}
+	ecsClient, err := ECSService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicEipEndpoints", "connection_error", err)
+		return err
+	}
+
+	request := vpc.CreateDescribeEipAddressesRequest()
+	request.Scheme = "https"
+	request.PageSize = requests.NewInteger(50)
+	request.PageNumber = requests.NewInteger(1)
+
+	count := 0
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.DescribeEipAddresses(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicEipEndpoints", "query_error", err, "request", request)
+			return err
+		}
+		for _, eip := range response.EipAddresses.EipAddress {
+			row := publicEndpointRow{
+				ResourceType:           publicEndpointTypeEip,
+				ResourceId:             eip.AllocationId,
+				ResourceName:           eip.Name,
+				PublicIp:               eip.IpAddress,
+				AssociatedResourceType: eip.InstanceType,
+				AssociatedResourceId:   eip.InstanceId,
+				Region:                 region,
+			}
+			securityGroupIds, err := getEipSecurityGroupIds(ctx, d, ecsClient, eip)
+			if err != nil {
+				plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicEipEndpoints", "security_group_error", err, "eip", eip.AllocationId)
+				return err
+			}
+			if len(securityGroupIds) > 0 {
+				row.SecurityGroupIds = securityGroupIds
+				row.OpenPorts, err = getSecurityGroupOpenPorts(ctx, d, ecsClient, groups, securityGroupIds)
+				if err != nil {
+					plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicEipEndpoints", "security_group_error", err, "eip", eip.AllocationId)
+					return err
+				}
+			}
+			d.StreamListItem(ctx, row)
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+			count++
+		}
+		if count >= response.TotalCount {
+			break
+		}
+		request.PageNumber = requests.NewInteger(response.PageNumber + 1)
+	}
+	return nil
+}
+
+func listPublicSlbEndpoints(ctx context.Context, d *plugin.QueryData, _ securityGroupCache) error {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	client, err := SLBService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicSlbEndpoints", "connection_error", err)
+		return err
+	}
+
+	request := slb.CreateDescribeLoadBalancersRequest()
+	request.Scheme = "https"
+	request.RegionId = region
+	request.AddressType = "internet"
+	request.PageSize = requests.NewInteger(50)
+	request.PageNumber = requests.NewInteger(1)
+
+	count := 0
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.DescribeLoadBalancers(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicSlbEndpoints", "query_error", err, "request", request)
+			return err
+		}
+		for _, loadBalancer := range response.LoadBalancers.LoadBalancer {
+			attributeRequest := slb.CreateDescribeLoadBalancerAttributeRequest()
+			attributeRequest.Scheme = "https"
+			attributeRequest.RegionId = region
+			attributeRequest.LoadBalancerId = loadBalancer.LoadBalancerId
+
+			d.WaitForListRateLimit(ctx)
+			attributes, err := client.DescribeLoadBalancerAttribute(attributeRequest)
+			if err != nil {
+				plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicSlbEndpoints", "query_error", err, "request", attributeRequest)
+				return err
+			}
+
+			var openPorts []publicEndpointPort
+			for _, listener := range attributes.ListenerPortsAndProtocol.ListenerPortAndProtocol {
+				port := listener.ListenerPort
+				openPorts = append(openPorts, publicEndpointPort{Protocol: listener.ListenerProtocol, FromPort: &port, ToPort: &port})
+			}
+
+			d.StreamListItem(ctx, publicEndpointRow{
+				ResourceType: publicEndpointTypeSlbLoadBalancer,
+				ResourceId:   loadBalancer.LoadBalancerId,
+				ResourceName: loadBalancer.LoadBalancerName,
+				PublicIp:     loadBalancer.Address,
+				OpenPorts:    openPorts,
+				Region:       region,
+			})
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+			count++
+		}
+		if count >= response.TotalCount {
+			break
+		}
+		request.PageNumber = requests.NewInteger(response.PageNumber + 1)
+	}
+	return nil
+}
+
+func listPublicRdsEndpoints(ctx context.Context, d *plugin.QueryData, _ securityGroupCache) error {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	client, err := RDSService(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicRdsEndpoints", "connection_error", err)
+		return err
+	}
+
+	request := rds.CreateDescribeDBInstancesRequest()
+	request.Scheme = "https"
+	request.PageSize = requests.NewInteger(50)
+	request.PageNumber = requests.NewInteger(1)
+
+	count := 0
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.DescribeDBInstances(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicRdsEndpoints", "query_error", err, "request", request)
+			return err
+		}
+		for _, instance := range response.Items.DBInstance {
+			count++
+
+			netInfoRequest := rds.CreateDescribeDBInstanceNetInfoRequest()
+			netInfoRequest.Scheme = "https"
+			netInfoRequest.DBInstanceId = instance.DBInstanceId
+
+			d.WaitForListRateLimit(ctx)
+			netInfo, err := client.DescribeDBInstanceNetInfo(netInfoRequest)
+			if err != nil {
+				plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicRdsEndpoints", "query_error", err, "request", netInfoRequest)
+				return err
+			}
+
+			for _, info := range netInfo.DBInstanceNetInfos.DBInstanceNetInfo {
+				if info.IPType != "Public" {
+					continue
+				}
+				row := publicEndpointRow{
+					ResourceType: publicEndpointTypeRdsInstance,
+					ResourceId:   instance.DBInstanceId,
+					ResourceName: instance.DBInstanceDescription,
+					PublicIp:     info.IPAddress,
+					PublicDns:    info.ConnectionString,
+					Region:       region,
+				}
+				if port, err := strconv.Atoi(info.Port); err == nil {
+					row.OpenPorts = []publicEndpointPort{{Protocol: "TCP", FromPort: &port, ToPort: &port}}
+				}
+				d.StreamListItem(ctx, row)
+				if d.RowsRemaining(ctx) == 0 {
+					return nil
+				}
+			}
+		}
+		if count >= response.TotalRecordCount {
+			break
+		}
+		request.PageNumber = requests.NewInteger(response.PageNumber + 1)
+	}
+	return nil
+}
+
+func listPublicKubernetesEndpoints(ctx context.Context, d *plugin.QueryData, _ securityGroupCache) error {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	client, err := ContainerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicKubernetesEndpoints", "connection_error", err)
+		return err
+	}
+
+	request := cs.CreateDescribeClustersV1Request()
+	request.Scheme = "https"
+	request.QueryParams["RegionId"] = region
+	request.PageSize = requests.NewInteger(50)
+	request.PageNumber = requests.NewInteger(1)
+
+	count := 0
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.DescribeClustersV1(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicKubernetesEndpoints", "query_error", err, "request", request)
+			return err
+		}
+		var result struct {
+			Clusters []struct {
+				ClusterId string `json:"cluster_id"`
+				Name      string `json:"name"`
+				RegionId  string `json:"region_id"`
+				MasterUrl string `json:"master_url"`
+			} `json:"clusters"`
+			PageInfo struct {
+				PageNumber int `json:"page_number"`
+				TotalCount int `json:"total_count"`
+			} `json:"page_info"`
+		}
+		if err := json.Unmarshal([]byte(response.GetHttpContentString()), &result); err != nil {
+			plugin.Logger(ctx).Error("alicloud_public_endpoint.listPublicKubernetesEndpoints", "json.unmarshal", err)
+			return err
+		}
+		for _, cluster := range result.Clusters {
+			count++
+			if cluster.RegionId != "" && cluster.RegionId != region {
+				continue
+			}
+			var masterUrl struct {
+				ApiServerEndpoint string `json:"api_server_endpoint"`
+			}
+			if cluster.MasterUrl == "" || json.Unmarshal([]byte(cluster.MasterUrl), &masterUrl) != nil || masterUrl.ApiServerEndpoint == "" {
+				continue
+			}
+			endpoint, err := url.Parse(masterUrl.ApiServerEndpoint)
+			if err != nil {
+				continue
+			}
+
+			row := publicEndpointRow{
+				ResourceType: publicEndpointTypeKubernetesCluster,
+				ResourceId:   cluster.ClusterId,
+				ResourceName: cluster.Name,
+				Region:       region,
+			}
+			if _, err := netip.ParseAddr(endpoint.Hostname()); err == nil {
+				row.PublicIp = endpoint.Hostname()
+			} else {
+				row.PublicDns = endpoint.Hostname()
+			}
+			if port, err := strconv.Atoi(endpoint.Port()); err == nil {
+				row.OpenPorts = []publicEndpointPort{{Protocol: "TCP", FromPort: &port, ToPort: &port}}
+			}
+
+			d.StreamListItem(ctx, row)
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+		if count >= result.PageInfo.TotalCount || len(result.Clusters) == 0 {
+			break
+		}
+		request.PageNumber = requests.NewInteger(result.PageInfo.PageNumber + 1)
+	}
+	return nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getEipSecurityGroupIds returns the security groups protecting the ECS
+// instance or network interface an EIP is bound to
+func getEipSecurityGroupIds(ctx context.Context, d *plugin.QueryData, client *ecs.Client, eip vpc.EipAddress) ([]string, error) {
+	switch eip.InstanceType {
+	case "EcsInstance", "NetworkInterface":
+		endpoint, err := resolveNetworkPathEndpoint(ctx, d, client, eip.InstanceId)
+		if err != nil || endpoint == nil {
+			return nil, err
+		}
+		return endpoint.SecurityGroupIds, nil
+	}
+	return nil, nil
+}
+
+// securityGroupCache holds the rules of the security groups read during a
+// query of a region, by security group ID
+type securityGroupCache map[string]securityGroupRuleSet
+
+// get returns the rules of security groups, reading only the groups which
+// are not in the cache yet
+func (c securityGroupCache) get(ctx context.Context, d *plugin.QueryData, client *ecs.Client, securityGroupIds []string) ([]securityGroupRuleSet, error) {
+	var missing []string
+	for _, id := range securityGroupIds {
+		if _, ok := c[id]; !ok && !slices.Contains(missing, id) {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		groups, err := getNetworkPathSecurityGroups(ctx, d, client, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range groups {
+			c[group.SecurityGroupId] = group
+		}
+	}
+
+	groups := make([]securityGroupRuleSet, 0, len(securityGroupIds))
+	for _, id := range securityGroupIds {
+		groups = append(groups, c[id])
+	}
+	return groups, nil
+}
+
+// getSecurityGroupOpenPorts returns the inbound rules open to any address that
+// are not overridden by a higher priority drop rule
+func getSecurityGroupOpenPorts(ctx context.Context, d *plugin.QueryData, client *ecs.Client, cache securityGroupCache, securityGroupIds []string) ([]publicEndpointPort, error) {
+	groups, err := cache.get(ctx, d, client, securityGroupIds)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var openPorts []publicEndpointPort
+	for _, group := range groups {
+		for _, permission := range group.Permissions {
+			if !strings.EqualFold(permission.Direction, "ingress") || !strings.EqualFold(permission.Policy, "accept") {
+				continue
+			}
+
+			var internet networkPathEndpoint
+			switch {
+			case permission.SourceCidrIp == "0.0.0.0/0":
+				internet = networkPathEndpoint{Type: "cidr", Prefix: netip.MustParsePrefix("0.0.0.0/0")}
+			case permission.Ipv6SourceCidrIp == "::/0":
+				internet = networkPathEndpoint{Type: "cidr", Prefix: netip.MustParsePrefix("::/0")}
+			default:
+				continue
+			}
+
+			port := publicEndpointPort{Protocol: strings.ToUpper(permission.IpProtocol)}
+			flow := networkPathFlow{Protocol: strings.ToLower(permission.IpProtocol)}
+			if from, to, ok := parseSecurityGroupPortRange(permission.IpProtocol, permission.PortRange); ok {
+				port.FromPort, port.ToPort = &from, &to
+				if flow.Protocol == "all" {
+					flow.Protocol = "tcp"
+				}
+				flow.Port = from
+			}
+
+			// Skip rules shadowed by a drop rule for the same traffic
+			if evaluateSecurityGroups("", "ingress", groups, internet, flow).Decision != networkPathDecisionAllow {
+				continue
+			}
+
+			key := fmt.Sprintf("%s/%s", port.Protocol, permission.PortRange)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			openPorts = append(openPorts, port)
+		}
+	}
+	return openPorts, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func publicEndpointTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	row := d.HydrateItem.(publicEndpointRow)
+
+	address := row.PublicDns
+	if address == "" {
+		address = row.PublicIp
+	}
+	return row.ResourceId + " (" + address + ")", nil
+}
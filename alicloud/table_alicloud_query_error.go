@@ -0,0 +1,118 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudQueryError(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_query_error",
+		Description: "Errors ignored by the queries of the connection because of the ignore_error_codes config argument, one row per table, region, hydrate function and error code.",
+		List: &plugin.ListConfig{
+			Hydrate: listQueryErrors,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "table_name", Require: plugin.Optional},
+				{Name: "region", Require: plugin.Optional},
+				{Name: "error_code", Require: plugin.Optional},
+			},
+		},
+		// The errors change with every query of the other tables
+		Cache: &plugin.TableCacheOptions{
+			Enabled: false,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "table_name",
+				Description: "The name of the table whose query ignored the error.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hydrate",
+				Description: "The name of the hydrate function that returned the error.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "error_code",
+				Description: "The error code returned by the API.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_code",
+				Description: "The HTTP status code returned by the API.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "error_message",
+				Description: "The message of the last error.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "count",
+				Description: "The number of times the error was ignored since the plugin started.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "first_seen",
+				Description: "The time when the error was first ignored.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_seen",
+				Description: "The time when the error was last ignored.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ErrorCode"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listQueryErrors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	for _, queryError := range getQueryErrors(d.Connection.Name) {
+		if d.EqualsQualString("table_name") != "" && queryError.TableName != d.EqualsQualString("table_name") {
+			continue
+		}
+		if d.EqualsQualString("region") != "" && queryError.Region != d.EqualsQualString("region") {
+			continue
+		}
+		if d.EqualsQualString("error_code") != "" && queryError.ErrorCode != d.EqualsQualString("error_code") {
+			continue
+		}
+
+		d.StreamListItem(ctx, queryError)
+		// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
@@ -28,6 +28,12 @@ func tableAlicloudRAMAccessKey(_ context.Context) *plugin.Table {
 			Hydrate:       listRAMUserAccessKeys,
 			Tags:          map[string]string{"service": "ram", "action": "ListAccessKeys"},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getRAMAccessKeyLastUsed,
+				Tags: map[string]string{"service": "ram", "action": "GetAccessKeyLastUsed"},
+			},
+		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "user_name",
@@ -49,6 +55,13 @@ func tableAlicloudRAMAccessKey(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Description: "The time when the AccessKey pair was created.",
 			},
+			{
+				Name:        "last_used_date",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The time when the AccessKey pair was last used. The service and region of the last use are not returned by the GetAccessKeyLastUsed API.",
+				Hydrate:     getRAMAccessKeyLastUsed,
+				Transform:   transform.FromField("LastUsedDate").NullIfZero(),
+			},
 
 			// steampipe common columns
 			{
@@ -118,6 +131,30 @@ func listRAMUserAccessKeys(ctx context.Context, d *plugin.QueryData, h *plugin.H
 
 //// HYDRATE FUNCTIONS
 
+func getRAMAccessKeyLastUsed(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := RAMService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_access_key.getRAMAccessKeyLastUsed", "connection_error", err)
+		return nil, err
+	}
+
+	i := h.Item.(accessKeyRow)
+
+	request := ram.CreateGetAccessKeyLastUsedRequest()
+	request.Scheme = "https"
+	request.UserName = i.UserName
+	request.UserAccessKeyId = i.AccessKeyId
+
+	response, err := client.GetAccessKeyLastUsed(request)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_access_key.getRAMAccessKeyLastUsed", "query_error", err, "request", request)
+		return nil, err
+	}
+
+	return response.AccessKeyLastUsed, nil
+}
+
 func getAccessKeyArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getAccessKeyArn")
 
@@ -132,7 +169,7 @@ func getAccessKeyArn(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:ram::" + accountID + ":user/" + i.UserName + "/accesskey/" + i.AccessKeyId}
+	akas := []string{buildArn("ram", "", accountID, "user", i.UserName+"/accesskey/"+i.AccessKeyId)}
 
 	return akas, nil
 }
@@ -4,9 +4,15 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/gocarina/gocsv"
+	"github.com/sethvargo/go-retry"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -226,6 +232,12 @@ func tableAlicloudRAMCredentialReport(ctx context.Context) *plugin.Table {
 				Description: "Specifies the time when the credential report has been generated.",
 				Type:        proto.ColumnType_TIMESTAMP,
 			},
+			{
+				Name:        "report_age",
+				Description: "The number of seconds elapsed since the credential report was generated.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(credentialReportAgeInSeconds),
+			},
 
 			// alicloud standard columns
 			{
@@ -243,37 +255,37 @@ func tableAlicloudRAMCredentialReport(ctx context.Context) *plugin.Table {
 
 func listRAMCredentialReports(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	// Create service connection
-	client, err := RAMService(ctx, d)
+	client, err := IMSService(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("alicloud_ram_credential_report.listRAMCredentialReports", "connection_error", err)
 		return nil, err
 	}
 
-	// Create a new request to retrieve the credential report.
 	// To maintain consistency within the authentication module, we chose not to use
 	// the "github.com/alibabacloud-go/ims-20190815/client" and "github.com/alibabacloud-go/tea-rpc/client" packages.
 	// Instead, we opted for the "github.com/aliyun/alibaba-cloud-sdk-go/sdk" package to handle authentication and make the API call.
-
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Scheme = "https"
-	request.Domain = "ims.aliyuncs.com"
-	request.Version = "2019-08-15"
-	request.ApiName = "GetCredentialReport"
-
-	// Make the API call
-	response, err := client.ProcessCommonRequest(request)
-	if err != nil {
-		plugin.Logger(ctx).Error("alicloud_ram_credential_report.listRAMCredentialReports", "Api_error", err)
+	credentialReportResponse, err := getRAMCredentialReport(client)
+	if err != nil && !isCredentialReportNotReadyError(err) {
+		plugin.Logger(ctx).Error("alicloud_ram_credential_report.listRAMCredentialReports", "api_error", err)
 		return nil, err
 	}
 
-	// Unmarshal the response to the struct
-	var credentialReportResponse GetCredentialReportResponse
-	err = json.Unmarshal(response.GetHttpContentBytes(), &credentialReportResponse)
-	if err != nil {
-		plugin.Logger(ctx).Error("alicloud_ram_credential_report.listRAMCredentialReports", "unmarshal_error", err)
-		return nil, err
+	// Generate a new report if there is none yet or the current one is too old.
+	// A report whose generation time cannot be parsed is treated as stale.
+	stale := err != nil
+	if !stale {
+		age, err := credentialReportAge(credentialReportResponse.GeneratedTime)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_ram_credential_report.listRAMCredentialReports", "generated_time_error", err)
+		}
+		stale = err != nil || age > getCredentialReportMaxAge(d)
+	}
+	if stale {
+		credentialReportResponse, err = generateRAMCredentialReport(ctx, client)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_ram_credential_report.listRAMCredentialReports", "generate_error", err)
+			return nil, err
+		}
 	}
 
 	// The report is Base64-encoded. After decoding the report, the credential report is in the CSV format.
@@ -301,6 +313,128 @@ func listRAMCredentialReports(ctx context.Context, d *plugin.QueryData, _ *plugi
 	return nil, nil
 }
 
+//// HYDRATE FUNCTIONS
+
+func getRAMCredentialReport(client *ram.Client) (*GetCredentialReportResponse, error) {
+	request := newIMSCommonRequest("GetCredentialReport")
+
+	response, err := client.ProcessCommonRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var credentialReportResponse GetCredentialReportResponse
+	if err := json.Unmarshal(response.GetHttpContentBytes(), &credentialReportResponse); err != nil {
+		return nil, err
+	}
+	return &credentialReportResponse, nil
+}
+
+// generateRAMCredentialReport starts the generation of a new credential report
+// and polls with an exponential backoff until it is ready
+func generateRAMCredentialReport(ctx context.Context, client *ram.Client) (*GetCredentialReportResponse, error) {
+	var credentialReportResponse *GetCredentialReportResponse
+
+	b := retry.NewExponential(2 * time.Second)
+	b = retry.WithCappedDuration(30*time.Second, b)
+	b = retry.WithMaxDuration(5*time.Minute, b)
+
+	err := retry.Do(ctx, b, func(ctx context.Context) error {
+		response, err := client.ProcessCommonRequest(newIMSCommonRequest("GenerateCredentialReport"))
+		if err != nil {
+			if serverErr, ok := err.(*errors.ServerError); ok && serverErr.ErrorCode() == "Throttling.User" {
+				return retry.RetryableError(err)
+			}
+			return err
+		}
+
+		var generateResponse GenerateCredentialReportResponse
+		if err := json.Unmarshal(response.GetHttpContentBytes(), &generateResponse); err != nil {
+			return err
+		}
+		if generateResponse.State == nil || *generateResponse.State != "COMPLETE" {
+			plugin.Logger(ctx).Debug("alicloud_ram_credential_report.generateRAMCredentialReport", "state", generateResponse.State)
+			return retry.RetryableError(fmt.Errorf("credential report is not ready"))
+		}
+
+		credentialReportResponse, err = getRAMCredentialReport(client)
+		if err != nil {
+			if isCredentialReportNotReadyError(err) {
+				return retry.RetryableError(err)
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return credentialReportResponse, nil
+}
+
+// newIMSCommonRequest returns a request of the IMS API, sent to the endpoint of
+// the client returned by IMSService
+func newIMSCommonRequest(apiName string) *requests.CommonRequest {
+	request := requests.NewCommonRequest()
+	request.Method = "POST"
+	request.Scheme = "https"
+	request.Version = "2019-08-15"
+	request.ApiName = apiName
+	return request
+}
+
+// isCredentialReportNotReadyError returns true if the credential report has
+// not been generated yet, has expired or is still being generated
+func isCredentialReportNotReadyError(err error) bool {
+	if serverErr, ok := err.(*errors.ServerError); ok {
+		return strings.Contains(serverErr.ErrorCode(), "CredentialReport")
+	}
+	return false
+}
+
+// getCredentialReportMaxAge returns the maximum age of the credential report
+// set in the connection config, defaulting to 24 hours
+func getCredentialReportMaxAge(d *plugin.QueryData) time.Duration {
+	alicloudConfig := GetConfig(d.Connection)
+	if alicloudConfig.CredentialReportMaxAge != nil {
+		return time.Duration(*alicloudConfig.CredentialReportMaxAge) * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// credentialReportAge returns the time elapsed since the report was generated
+func credentialReportAge(generatedTime *string) (time.Duration, error) {
+	if generatedTime == nil {
+		return 0, fmt.Errorf("credential report has no generation time")
+	}
+	t, err := time.Parse(time.RFC3339, *generatedTime)
+	if err != nil {
+		return 0, err
+	}
+	return time.Since(t), nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func credentialReportAgeInSeconds(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	row := d.HydrateItem.(*alicloudRamCredentialReportResult)
+	if row.GeneratedTime == nil {
+		return nil, nil
+	}
+	age, err := credentialReportAge(row.GeneratedTime)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_credential_report.credentialReportAgeInSeconds", "generated_time_error", err)
+		return nil, nil
+	}
+	return int64(age.Seconds()), nil
+}
+
+type GenerateCredentialReportResponse struct {
+	RequestId *string `json:"RequestId,omitempty" xml:"RequestId,omitempty" require:"true"`
+	State     *string `json:"State,omitempty" xml:"State,omitempty" require:"true"`
+}
+
 type GetCredentialReportResponse struct {
 	RequestId     *string `json:"RequestId,omitempty" xml:"RequestId,omitempty" require:"true"`
 	Content       *string `json:"Content,omitempty" xml:"Content,omitempty" require:"true"`
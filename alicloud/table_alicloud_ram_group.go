@@ -255,5 +255,5 @@ func getGroupArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	return "acs:ram::" + accountID + ":group/" + data.GroupName, nil
+	return buildArn("ram", "", accountID, "group", data.GroupName), nil
 }
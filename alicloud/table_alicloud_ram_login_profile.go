@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type loginProfileRow struct {
+	UserName             string
+	ConsoleAccessEnabled bool
+	LoginProfile         *ram.LoginProfile
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudRAMLoginProfile(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_ram_login_profile",
+		Description: "Alibaba Cloud RAM user console logon profiles.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRAMUser,
+			Hydrate:       listRAMLoginProfiles,
+			Tags:          map[string]string{"service": "ram", "action": "GetLoginProfile"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "user_name",
+				Description: "The name of the RAM user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "console_access_enabled",
+				Description: "Indicates whether the RAM user has a console logon password.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "mfa_bind_required",
+				Description: "Indicates whether the RAM user must bind an MFA device at the next logon.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LoginProfile.MFABindRequired"),
+			},
+			{
+				Name:        "password_reset_required",
+				Description: "Indicates whether the RAM user must reset the password at the next logon.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LoginProfile.PasswordResetRequired"),
+			},
+			{
+				Name:        "create_date",
+				Description: "The time when the logon profile was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LoginProfile.CreateDate").NullIfZero(),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserName"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listRAMLoginProfiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := RAMService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_login_profile.listRAMLoginProfiles", "connection_error", err)
+		return nil, err
+	}
+
+	user := h.Item.(userInfo)
+
+	request := ram.CreateGetLoginProfileRequest()
+	request.Scheme = "https"
+	request.UserName = user.UserName
+
+	row := loginProfileRow{UserName: user.UserName}
+
+	response, err := client.GetLoginProfile(request)
+	if err != nil {
+		// Users without a console password have no logon profile
+		serverErr, ok := err.(*errors.ServerError)
+		if !ok || serverErr.ErrorCode() != "EntityNotExist.User.LoginProfile" {
+			plugin.Logger(ctx).Error("alicloud_ram_login_profile.listRAMLoginProfiles", "query_error", err, "request", request)
+			return nil, err
+		}
+	} else {
+		row.ConsoleAccessEnabled = true
+		row.LoginProfile = &response.LoginProfile
+	}
+
+	d.StreamLeafListItem(ctx, row)
+	return nil, nil
+}
@@ -0,0 +1,224 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type ramOIDCProvider struct {
+	OIDCProviderName  string `json:"OIDCProviderName"`
+	Arn               string `json:"Arn"`
+	Description       string `json:"Description"`
+	IssuerUrl         string `json:"IssuerUrl"`
+	ClientIds         string `json:"ClientIds"`
+	Fingerprints      string `json:"Fingerprints"`
+	IssuanceLimitTime int64  `json:"IssuanceLimitTime"`
+	CreateDate        string `json:"CreateDate"`
+	UpdateDate        string `json:"UpdateDate"`
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudRAMOIDCProvider(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_ram_oidc_provider",
+		Description: "Alibaba Cloud RAM OIDC identity providers used for role-based single sign-on.",
+		List: &plugin.ListConfig{
+			Hydrate: listRAMOIDCProviders,
+			Tags:    map[string]string{"service": "ims", "action": "ListOIDCProviders"},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getRAMOIDCProvider,
+			Tags:       map[string]string{"service": "ims", "action": "GetOIDCProvider"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"EntityNotExist.OIDCProvider"}),
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OIDCProviderName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Alibaba Cloud Resource Name (ARN) of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "issuer_url",
+				Description: "The URL of the issuer of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "client_ids",
+				Description: "The client IDs that are allowed to use the identity provider.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ClientIds").Transform(splitCommaSeparatedString),
+			},
+			{
+				Name:        "fingerprints",
+				Description: "The fingerprints of the HTTPS CA certificates of the issuer.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Fingerprints").Transform(splitCommaSeparatedString),
+			},
+			{
+				Name:        "issuance_limit_time",
+				Description: "The earliest time, in hours, when an OIDC token can be issued before it is used to assume a role.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "create_date",
+				Description: "The time when the identity provider was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "update_date",
+				Description: "The time when the identity provider was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateDate").NullIfZero(),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OIDCProviderName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listRAMOIDCProviders(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := IMSService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_oidc_provider.listRAMOIDCProviders", "connection_error", err)
+		return nil, err
+	}
+
+	request := newIMSCommonRequest("ListOIDCProviders")
+	request.QueryParams["MaxItems"] = "100"
+
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.ProcessCommonRequest(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_ram_oidc_provider.listRAMOIDCProviders", "query_error", err, "request", request)
+			return nil, err
+		}
+
+		var result struct {
+			OIDCProviders struct {
+				OIDCProvider []ramOIDCProvider `json:"OIDCProvider"`
+			} `json:"OIDCProviders"`
+			IsTruncated bool   `json:"IsTruncated"`
+			Marker      string `json:"Marker"`
+		}
+		if err := json.Unmarshal(response.GetHttpContentBytes(), &result); err != nil {
+			plugin.Logger(ctx).Error("alicloud_ram_oidc_provider.listRAMOIDCProviders", "unmarshal_error", err)
+			return nil, err
+		}
+
+		for _, provider := range result.OIDCProviders.OIDCProvider {
+			d.StreamListItem(ctx, provider)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if !result.IsTruncated {
+			break
+		}
+		request.QueryParams["Marker"] = result.Marker
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRAMOIDCProvider(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	client, err := IMSService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_oidc_provider.getRAMOIDCProvider", "connection_error", err)
+		return nil, err
+	}
+
+	request := newIMSCommonRequest("GetOIDCProvider")
+	request.QueryParams["OIDCProviderName"] = name
+
+	response, err := client.ProcessCommonRequest(request)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_oidc_provider.getRAMOIDCProvider", "query_error", err, "request", request)
+		return nil, err
+	}
+
+	var result struct {
+		OIDCProvider ramOIDCProvider `json:"OIDCProvider"`
+	}
+	if err := json.Unmarshal(response.GetHttpContentBytes(), &result); err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_oidc_provider.getRAMOIDCProvider", "unmarshal_error", err)
+		return nil, err
+	}
+
+	return result.OIDCProvider, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func splitCommaSeparatedString(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	value, ok := d.Value.(string)
+	if !ok || value == "" {
+		return []string{}, nil
+	}
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
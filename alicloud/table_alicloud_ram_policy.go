@@ -254,7 +254,7 @@ func getPolicyAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	return []string{"acs:ram::" + accountID + ":policy/" + data}, nil
+	return []string{buildArn("ram", "", accountID, "policy", data)}, nil
 }
 
 func policyName(item interface{}) string {
@@ -0,0 +1,222 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type ramSAMLProvider struct {
+	SAMLProviderName            string `json:"SAMLProviderName"`
+	Arn                         string `json:"Arn"`
+	Description                 string `json:"Description"`
+	CreateDate                  string `json:"CreateDate"`
+	UpdateDate                  string `json:"UpdateDate"`
+	EncodedSAMLMetadataDocument string `json:"EncodedSAMLMetadataDocument"`
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudRAMSAMLProvider(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_ram_saml_provider",
+		Description: "Alibaba Cloud RAM SAML identity providers used for role-based single sign-on.",
+		List: &plugin.ListConfig{
+			Hydrate: listRAMSAMLProviders,
+			Tags:    map[string]string{"service": "ims", "action": "ListSAMLProviders"},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getRAMSAMLProvider,
+			Tags:       map[string]string{"service": "ims", "action": "GetSAMLProvider"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"EntityNotExist.SAMLProvider"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getRAMSAMLProvider,
+				Tags: map[string]string{"service": "ims", "action": "GetSAMLProvider"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SAMLProviderName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Alibaba Cloud Resource Name (ARN) of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_date",
+				Description: "The time when the identity provider was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "update_date",
+				Description: "The time when the identity provider was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateDate").NullIfZero(),
+			},
+			{
+				Name:        "encoded_saml_metadata_document",
+				Description: "The Base64-encoded metadata document of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRAMSAMLProvider,
+				Transform:   transform.FromField("EncodedSAMLMetadataDocument"),
+			},
+			{
+				Name:        "saml_metadata_document",
+				Description: "The decoded metadata document of the identity provider, including its signing certificates.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRAMSAMLProvider,
+				Transform:   transform.FromField("EncodedSAMLMetadataDocument").Transform(base64DecodeString),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SAMLProviderName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listRAMSAMLProviders(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := IMSService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_saml_provider.listRAMSAMLProviders", "connection_error", err)
+		return nil, err
+	}
+
+	request := newIMSCommonRequest("ListSAMLProviders")
+	request.QueryParams["MaxItems"] = "100"
+
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.ProcessCommonRequest(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_ram_saml_provider.listRAMSAMLProviders", "query_error", err, "request", request)
+			return nil, err
+		}
+
+		var result struct {
+			SAMLProviders struct {
+				SAMLProvider []ramSAMLProvider `json:"SAMLProvider"`
+			} `json:"SAMLProviders"`
+			IsTruncated bool   `json:"IsTruncated"`
+			Marker      string `json:"Marker"`
+		}
+		if err := json.Unmarshal(response.GetHttpContentBytes(), &result); err != nil {
+			plugin.Logger(ctx).Error("alicloud_ram_saml_provider.listRAMSAMLProviders", "unmarshal_error", err)
+			return nil, err
+		}
+
+		for _, provider := range result.SAMLProviders.SAMLProvider {
+			d.StreamListItem(ctx, provider)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if !result.IsTruncated {
+			break
+		}
+		request.QueryParams["Marker"] = result.Marker
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRAMSAMLProvider(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = h.Item.(ramSAMLProvider).SAMLProviderName
+	} else {
+		name = d.EqualsQualString("name")
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	client, err := IMSService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_saml_provider.getRAMSAMLProvider", "connection_error", err)
+		return nil, err
+	}
+
+	request := newIMSCommonRequest("GetSAMLProvider")
+	request.QueryParams["SAMLProviderName"] = name
+
+	response, err := client.ProcessCommonRequest(request)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_saml_provider.getRAMSAMLProvider", "query_error", err, "request", request)
+		return nil, err
+	}
+
+	var result struct {
+		SAMLProvider ramSAMLProvider `json:"SAMLProvider"`
+	}
+	if err := json.Unmarshal(response.GetHttpContentBytes(), &result); err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_saml_provider.getRAMSAMLProvider", "unmarshal_error", err)
+		return nil, err
+	}
+
+	return result.SAMLProvider, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func base64DecodeString(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	encoded, ok := d.Value.(string)
+	if !ok || encoded == "" {
+		return nil, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return string(decoded), nil
+}
@@ -57,6 +57,10 @@ func tableAlicloudRAMUser(ctx context.Context) *plugin.Table {
 				Func: getRAMUserGroups,
 				Tags: map[string]string{"service": "ram", "action": "ListGroupsForUser"},
 			},
+			{
+				Func: getCsUserPermissions,
+				Tags: map[string]string{"service": "cs", "action": "DescribeUserPermission"},
+			},
 		},
 		Columns: []*plugin.Column{
 			// Top columns
@@ -93,6 +97,7 @@ func tableAlicloudRAMUser(ctx context.Context) *plugin.Table {
 				Description: "The time when the RAM user last logged on to the console by using the password.",
 				Type:        proto.ColumnType_TIMESTAMP,
 				Hydrate:     getRAMUser,
+				Transform:   transform.FromField("LastLoginDate").NullIfZero(),
 			},
 			{
 				Name:        "mobile_phone",
@@ -381,14 +386,15 @@ func getUserArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	return "acs:ram::" + accountID + ":user/" + data.UserName, nil
+	return buildArn("ram", "", accountID, "user", data.UserName), nil
 }
 
 func getCsUserPermissions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getCsUserPermissions")
 
-	// Create service connection
-	client, err := RAMService(ctx, d)
+	// Create service connection. The permissions are read from the Container
+	// service, whose client resolves the endpoint of the product.
+	client, err := ContainerService(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("getCsUserPermissions", "connection_error", err)
 		return nil, err
@@ -399,7 +405,7 @@ func getCsUserPermissions(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	request := requests.NewCommonRequest()
 	request.Method = "GET"
 	request.Scheme = "https"
-	request.Domain = "cs.aliyuncs.com"
+	request.Product = "CS"
 	request.Version = "2015-12-15"
 	request.PathPattern = "/permissions/users/" + data.UserId
 	request.Headers["Content-Type"] = "application/json"
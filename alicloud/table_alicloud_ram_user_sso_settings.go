@@ -0,0 +1,103 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type ramUserSsoSettings struct {
+	SsoEnabled         bool   `json:"SsoEnabled"`
+	SsoLoginWithDomain bool   `json:"SsoLoginWithDomain"`
+	MetadataDocument   string `json:"MetadataDocument"`
+	AuxiliaryDomain    string `json:"AuxiliaryDomain"`
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudRAMUserSsoSettings(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_ram_user_sso_settings",
+		Description: "Alibaba Cloud RAM user-based single sign-on (SSO) settings of the account.",
+		List: &plugin.ListConfig{
+			Hydrate: listRAMUserSsoSettings,
+			Tags:    map[string]string{"service": "ims", "action": "GetUserSsoSettings"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "sso_enabled",
+				Description: "Indicates whether user-based SSO is enabled. If enabled, RAM users must sign in to the console through the identity provider.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "sso_login_with_domain",
+				Description: "Indicates whether the domain name suffix in the NameID of the SAML response must match the auxiliary domain or the default domain of the account.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "auxiliary_domain",
+				Description: "The auxiliary domain name used to match RAM users during SSO.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metadata_document",
+				Description: "The Base64-encoded metadata document of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "decoded_metadata_document",
+				Description: "The decoded metadata document of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MetadataDocument").Transform(base64DecodeString),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listRAMUserSsoSettings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := IMSService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_user_sso_settings.listRAMUserSsoSettings", "connection_error", err)
+		return nil, err
+	}
+
+	request := newIMSCommonRequest("GetUserSsoSettings")
+
+	response, err := client.ProcessCommonRequest(request)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_user_sso_settings.listRAMUserSsoSettings", "query_error", err, "request", request)
+		return nil, err
+	}
+
+	var result struct {
+		UserSsoSettings ramUserSsoSettings `json:"UserSsoSettings"`
+	}
+	if err := json.Unmarshal(response.GetHttpContentBytes(), &result); err != nil {
+		plugin.Logger(ctx).Error("alicloud_ram_user_sso_settings.listRAMUserSsoSettings", "unmarshal_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, result.UserSsoSettings)
+	return nil, nil
+}
@@ -873,7 +873,7 @@ func getRdsInstanceARN(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	}
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
-	return "arn:acs:rds:" + region + ":" + accountID + ":instance/" + instanceID, nil
+	return buildArn("rds", region, accountID, "instance", instanceID), nil
 }
 
 func getSqlCollectorPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -0,0 +1,208 @@
+package alicloud
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type resourceInfo struct {
+	Arn          string
+	ResourceType string
+	ResourceId   string
+	Name         string
+	Region       string
+	AccountId    string
+	Tags         interface{}
+	CreatedTime  *time.Time
+	Akas         interface{}
+	Raw          interface{}
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudResource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_resource",
+		Description: "All the resources of the account, listed from every resource table of the plugin.",
+		List: &plugin.ListConfig{
+			Hydrate: listResources,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "region", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Alibaba Cloud Resource Name (ARN) of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource, which is the name of the table of the resource without the alicloud_ prefix, such as ecs_instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource in its ARN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the resource, or its ID if it has no name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The time when the resource was created, if it is returned by the list API of the table of the resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "raw",
+				Description: "The resource as returned by the list API of its table.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listResources(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	resourceType := d.EqualsQualString("resource_type")
+	var tables []*plugin.Table
+	for _, table := range getResourceTables(d.Table.Plugin) {
+		if resourceType == "" || getResourceType(table) == resourceType {
+			tables = append(tables, table)
+		}
+	}
+
+	var lock sync.Mutex
+	err := listResourceTables(ctx, d, tables, d.EqualsQualString("region"), func(ctx context.Context, item resourceTableItem) error {
+		resource, err := getResourceInfo(ctx, d, item)
+		if err != nil {
+			return err
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		d.StreamListItem(ctx, resource)
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource.listResources", "query_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getResourceInfo maps a list item of a resource table to a row of
+// alicloud_resource. The region and account of the resource are those of its
+// ARN, and its name and creation time are read from the list item. The tags,
+// which may need more API calls, are only evaluated if they are requested.
+func getResourceInfo(ctx context.Context, d *plugin.QueryData, item resourceTableItem) (*resourceInfo, error) {
+	arn, err := item.arn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resource := &resourceInfo{
+		Arn:          arn,
+		ResourceType: getResourceType(item.resourceTable()),
+		Region:       "global",
+		Raw:          item.listItem(),
+	}
+	if arn != "" {
+		resource.Akas = []string{arn}
+	}
+	if parsed, err := parseArn(arn); err == nil {
+		resource.ResourceId = parsed.ResourceId
+		resource.AccountId = parsed.AccountId
+		if parsed.Region != "" {
+			resource.Region = parsed.Region
+		}
+	}
+
+	title, err := item.columnValue(ctx, "title")
+	if err != nil {
+		return nil, err
+	}
+	resource.Name = types.SafeString(title)
+	if resource.Name == "" {
+		resource.Name = resource.ResourceId
+	}
+
+	if slices.Contains(d.QueryContext.Columns, "tags") {
+		resource.Tags, err = item.tags(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if slices.Contains(d.QueryContext.Columns, "created_time") {
+		column := getCreatedTimeColumn(item.resourceTable())
+		if column != "" {
+			// Tables return creation times in different formats, a time which
+			// cannot be parsed is left empty rather than failing the query
+			value, err := item.columnValue(ctx, column)
+			if err != nil {
+				plugin.Logger(ctx).Warn("alicloud_resource.getResourceInfo", "table", item.resourceTable().Name, "column", column, "transform_error", err)
+			} else if createdTime, err := types.ToTime(value); err == nil && !createdTime.IsZero() {
+				resource.CreatedTime = &createdTime
+			}
+		}
+	}
+
+	return resource, nil
+}
+
+// getCreatedTimeColumn returns the first timestamp column of a table whose
+// name refers to the creation of the resource, such as creation_time or
+// create_date
+func getCreatedTimeColumn(table *plugin.Table) string {
+	for _, column := range table.Columns {
+		if column.Type == proto.ColumnType_TIMESTAMP && strings.Contains(column.Name, "creat") {
+			return column.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,225 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcecenter"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudResourceCenterResource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_resource_center_resource",
+		Description: "Alibaba Cloud Resource Center search across all resource types and regions of the account.",
+		List: &plugin.ListConfig{
+			Hydrate: listResourceCenterResources,
+			Tags:    map[string]string{"service": "resourcecenter", "action": "SearchResources"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "resource_group_id", Require: plugin.Optional},
+				{Name: "region", Require: plugin.Optional},
+				{Name: "tag_key", Require: plugin.Optional},
+				{Name: "tag_value", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource, such as ACS::ECS::Instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_group_id",
+				Description: "The ID of the resource group to which the resource belongs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "zone_id",
+				Description: "The zone of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the resource was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "ip_addresses",
+				Description: "The IP addresses of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tag_key",
+				Description: "The tag key used to filter the resources. Only set when provided as a qual.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("tag_key"),
+			},
+			{
+				Name:        "tag_value",
+				Description: "The tag value used to filter the resources, together with tag_key. Only set when provided as a qual.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("tag_value"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(resourceCenterTagsSrc),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(resourceCenterTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(resourceCenterResourceTitle),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegionId"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceCenterResources(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := ResourceCenterService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource_center_resource.listResourceCenterResources", "connection_error", err)
+		return nil, err
+	}
+
+	request := resourcecenter.CreateSearchResourcesRequest()
+	request.Scheme = "https"
+	request.MaxResults = requests.NewInteger(100)
+	if resourceGroupId := d.EqualsQualString("resource_group_id"); resourceGroupId != "" {
+		request.ResourceGroupId = resourceGroupId
+	}
+
+	filters := []resourcecenter.SearchResourcesFilter{}
+	if resourceType := d.EqualsQualString("resource_type"); resourceType != "" {
+		filters = append(filters, resourceCenterFilter("ResourceType", resourceType))
+	}
+	if region := d.EqualsQualString("region"); region != "" {
+		filters = append(filters, resourceCenterFilter("RegionId", region))
+	}
+	if tagKey := d.EqualsQualString("tag_key"); tagKey != "" {
+		// Tag filters are passed as a JSON object, the value being optional
+		tag := map[string]string{"key": tagKey}
+		if tagValue := d.EqualsQualString("tag_value"); tagValue != "" {
+			tag["value"] = tagValue
+		}
+		value, err := json.Marshal(tag)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, resourceCenterFilter("Tag", string(value)))
+	}
+	if len(filters) > 0 {
+		request.Filter = &filters
+	}
+
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.SearchResources(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_resource_center_resource.listResourceCenterResources", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, resource := range response.Resources {
+			d.StreamListItem(ctx, resource)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.NextToken == "" {
+			break
+		}
+		request.NextToken = response.NextToken
+	}
+	return nil, nil
+}
+
+func resourceCenterFilter(key string, value string) resourcecenter.SearchResourcesFilter {
+	return resourcecenter.SearchResourcesFilter{
+		Key:       key,
+		MatchType: "Equals",
+		Value:     &[]string{value},
+	}
+}
+
+//// TRANSFORM FUNCTIONS
+
+func resourceCenterResourceTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	resource := d.HydrateItem.(resourcecenter.Resource)
+	if resource.ResourceName != "" {
+		return resource.ResourceName, nil
+	}
+	return resource.ResourceId, nil
+}
+
+func resourceCenterTagsSrc(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]resourcecenter.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	var turbotTagsMap []map[string]string
+	for _, i := range tags {
+		turbotTagsMap = append(turbotTagsMap, map[string]string{"Key": i.Key, "Value": i.Value})
+	}
+	return turbotTagsMap, nil
+}
+
+func resourceCenterTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]resourcecenter.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[i.Key] = i.Value
+	}
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,127 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudResourceDirectory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_resource_directory",
+		Description: "Alibaba Cloud Resource Directory, the multi-account organization of an enterprise.",
+		List: &plugin.ListConfig{
+			Hydrate: listResourceDirectories,
+			Tags:    map[string]string{"service": "resourcemanager", "action": "GetResourceDirectory"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "resource_directory_id",
+				Description: "The ID of the resource directory.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "root_folder_id",
+				Description: "The ID of the root folder.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "master_account_id",
+				Description: "The ID of the management account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "master_account_name",
+				Description: "The name of the management account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "control_policy_status",
+				Description: "The status of the control policy feature. Possible values are: Enabled, Disabled, Enabling and Disabling.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_deletion_status",
+				Description: "The status of the member deletion feature. Possible values are: Enabled and Disabled.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "identity_information",
+				Description: "The real-name verification information of the resource directory.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the resource directory was enabled.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceDirectoryId"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceDirectories(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	directory, err := getResourceDirectory(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource_directory.listResourceDirectories", "query_error", err)
+		return nil, err
+	}
+	if directory != nil {
+		d.StreamListItem(ctx, *directory)
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getResourceDirectory returns the resource directory the account belongs to,
+// or nil if the resource directory is not enabled
+func getResourceDirectory(ctx context.Context, d *plugin.QueryData) (*resourcemanager.ResourceDirectory, error) {
+	client, err := ResourceManagerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	request := resourcemanager.CreateGetResourceDirectoryRequest()
+	request.Scheme = "https"
+
+	response, err := client.GetResourceDirectory(request)
+	if err != nil {
+		if serverErr, ok := err.(*errors.ServerError); ok && serverErr.ErrorCode() == "EntityNotExists.ResourceDirectory" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &response.ResourceDirectory, nil
+}
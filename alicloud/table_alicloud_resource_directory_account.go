@@ -0,0 +1,299 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// effectiveControlPolicy is a control policy that applies to an account,
+// either attached directly to it or inherited from one of its folders
+type effectiveControlPolicy struct {
+	PolicyId    string
+	PolicyName  string
+	PolicyType  string
+	EffectScope string
+	TargetId    string
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudResourceDirectoryAccount(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_resource_directory_account",
+		Description: "Alibaba Cloud Resource Directory member accounts, with their folder path and the control policies that apply to them.",
+		List: &plugin.ListConfig{
+			Hydrate: listResourceDirectoryAccounts,
+			Tags:    map[string]string{"service": "resourcemanager", "action": "ListAccounts"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getResourceDirectoryAccountFolder,
+				Tags: map[string]string{"service": "resourcemanager", "action": "ListFoldersForParent"},
+			},
+			{
+				Func: getResourceDirectoryAccountControlPolicies,
+				Tags: map[string]string{"service": "resourcemanager", "action": "ListControlPolicyAttachmentsForTarget"},
+			},
+			{
+				Func: getResourceDirectoryAccountEffectiveControlPolicies,
+				Tags: map[string]string{"service": "resourcemanager", "action": "ListControlPolicyAttachmentsForTarget"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the member account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the member account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the member account. Possible values are: CloudAccount and ResourceAccount.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the member account, such as CreateSuccess or PromoteSuccess.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "join_method",
+				Description: "The way in which the member account joined the resource directory. Possible values are: invited and created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "join_time",
+				Description: "The time when the member account joined the resource directory.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("JoinTime").NullIfZero(),
+			},
+			{
+				Name:        "modify_time",
+				Description: "The time when the member account was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ModifyTime").NullIfZero(),
+			},
+			{
+				Name:        "folder_id",
+				Description: "The ID of the folder that contains the member account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "folder_path",
+				Description: "The names of the folders from the root folder to the folder that contains the member account, separated by slashes.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getResourceDirectoryAccountFolder,
+				Transform:   transform.FromField("PathNames").Transform(resourceDirectoryPathToString),
+			},
+			{
+				Name:        "folder_path_ids",
+				Description: "The IDs of the folders from the root folder to the folder that contains the member account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceDirectoryAccountFolder,
+				Transform:   transform.FromField("PathIds"),
+			},
+			{
+				Name:        "resource_directory_id",
+				Description: "The ID of the resource directory.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_directory_path",
+				Description: "The path of the member account in the resource directory, as returned by the API.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "control_policy_attachments",
+				Description: "The control policies attached directly to the member account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceDirectoryAccountControlPolicies,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "effective_control_policies",
+				Description: "The control policies that apply to the member account, including those inherited from its folders. TargetId is the folder or account the policy is attached to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceDirectoryAccountEffectiveControlPolicies,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached with the member account.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags.Tag").Transform(resourceManagerTagsSrc),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags.Tag").Transform(resourceManagerTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceDirectoryAccounts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := ResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource_directory_account.listResourceDirectoryAccounts", "connection_error", err)
+		return nil, err
+	}
+
+	request := resourcemanager.CreateListAccountsRequest()
+	request.Scheme = "https"
+	request.IncludeTags = requests.NewBoolean(true)
+	request.PageSize = requests.NewInteger(100)
+	request.PageNumber = requests.NewInteger(1)
+
+	count := 0
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.ListAccounts(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_resource_directory_account.listResourceDirectoryAccounts", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, account := range response.Accounts.Account {
+			d.StreamListItem(ctx, account)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+			count++
+		}
+		if count >= response.TotalCount || len(response.Accounts.Account) == 0 {
+			break
+		}
+		request.PageNumber = requests.NewInteger(response.PageNumber + 1)
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getResourceDirectoryAccountFolder(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	account := h.Item.(resourcemanager.AccountInListAccounts)
+
+	folder, err := getResourceDirectoryFolder(ctx, d, account.FolderId)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource_directory_account.getResourceDirectoryAccountFolder", "query_error", err, "folder_id", account.FolderId)
+		return nil, err
+	}
+	if folder == nil {
+		return nil, nil
+	}
+	return *folder, nil
+}
+
+func getResourceDirectoryAccountControlPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	account := h.Item.(resourcemanager.AccountInListAccounts)
+
+	attachments, err := getControlPolicyAttachmentsForTarget(ctx, d, account.AccountId)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource_directory_account.getResourceDirectoryAccountControlPolicies", "query_error", err, "id", account.AccountId)
+		return nil, err
+	}
+	return attachments, nil
+}
+
+func getResourceDirectoryAccountEffectiveControlPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	account := h.Item.(resourcemanager.AccountInListAccounts)
+
+	folder, err := getResourceDirectoryFolder(ctx, d, account.FolderId)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource_directory_account.getResourceDirectoryAccountEffectiveControlPolicies", "query_error", err, "folder_id", account.FolderId)
+		return nil, err
+	}
+
+	// Policies are inherited from every folder on the path, down to the account itself
+	targets := []string{}
+	if folder != nil {
+		targets = append(targets, folder.PathIds...)
+	}
+	targets = append(targets, account.AccountId)
+
+	policies := []effectiveControlPolicy{}
+	for _, target := range targets {
+		attachments, err := getControlPolicyAttachmentsForTarget(ctx, d, target)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_resource_directory_account.getResourceDirectoryAccountEffectiveControlPolicies", "query_error", err, "target_id", target)
+			return nil, err
+		}
+		for _, attachment := range attachments {
+			policies = append(policies, effectiveControlPolicy{
+				PolicyId:    attachment.PolicyId,
+				PolicyName:  attachment.PolicyName,
+				PolicyType:  attachment.PolicyType,
+				EffectScope: attachment.EffectScope,
+				TargetId:    target,
+			})
+		}
+	}
+	return policies, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func resourceManagerTagsSrc(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]resourcemanager.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	var turbotTagsMap []map[string]string
+	for _, i := range tags {
+		turbotTagsMap = append(turbotTagsMap, map[string]string{"Key": i.Key, "Value": i.Value})
+	}
+	return turbotTagsMap, nil
+}
+
+func resourceManagerTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]resourcemanager.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[i.Key] = i.Value
+	}
+	return turbotTagsMap, nil
+}
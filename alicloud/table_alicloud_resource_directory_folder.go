@@ -0,0 +1,267 @@
+package alicloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// resourceDirectoryFolder is a folder of the resource directory along with
+// the folders above it, starting from the root folder
+type resourceDirectoryFolder struct {
+	FolderId       string
+	FolderName     string
+	ParentFolderId string
+	CreateTime     string
+	PathIds        []string
+	PathNames      []string
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudResourceDirectoryFolder(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_resource_directory_folder",
+		Description: "Alibaba Cloud Resource Directory folders, with their path from the root folder.",
+		List: &plugin.ListConfig{
+			Hydrate: listResourceDirectoryFolders,
+			Tags:    map[string]string{"service": "resourcemanager", "action": "ListFoldersForParent"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getResourceDirectoryFolderControlPolicies,
+				Tags: map[string]string{"service": "resourcemanager", "action": "ListControlPolicyAttachmentsForTarget"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "folder_id",
+				Description: "The ID of the folder.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "folder_name",
+				Description: "The name of the folder.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_folder_id",
+				Description: "The ID of the parent folder. Empty for the root folder.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "path",
+				Description: "The names of the folders from the root folder to this folder, separated by slashes.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PathNames").Transform(resourceDirectoryPathToString),
+			},
+			{
+				Name:        "path_ids",
+				Description: "The IDs of the folders from the root folder to this folder.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PathIds"),
+			},
+			{
+				Name:        "depth",
+				Description: "The depth of the folder in the hierarchy. The root folder has a depth of 0.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(resourceDirectoryFolderDepth),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the folder was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "control_policy_attachments",
+				Description: "The control policies attached directly to the folder.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceDirectoryFolderControlPolicies,
+				Transform:   transform.FromValue(),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FolderName"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceDirectoryFolders(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	folders, err := getResourceDirectoryFolders(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource_directory_folder.listResourceDirectoryFolders", "query_error", err)
+		return nil, err
+	}
+
+	for _, folder := range folders {
+		d.StreamListItem(ctx, *folder)
+		// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getResourceDirectoryFolderControlPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	folder := h.Item.(resourceDirectoryFolder)
+
+	attachments, err := getControlPolicyAttachmentsForTarget(ctx, d, folder.FolderId)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource_directory_folder.getResourceDirectoryFolderControlPolicies", "query_error", err, "folder_id", folder.FolderId)
+		return nil, err
+	}
+	return attachments, nil
+}
+
+// getResourceDirectoryFolders returns every folder of the resource directory
+// in breadth-first order. The result is memoized for the connection since
+// it's needed by the folder and account tables.
+func getResourceDirectoryFolders(ctx context.Context, d *plugin.QueryData) ([]*resourceDirectoryFolder, error) {
+	folders, err := getResourceDirectoryFoldersMemoize(ctx, d, &plugin.HydrateData{})
+	if err != nil {
+		return nil, err
+	}
+	return folders.([]*resourceDirectoryFolder), nil
+}
+
+var getResourceDirectoryFoldersMemoize = plugin.HydrateFunc(getResourceDirectoryFoldersUncached).Memoize(memoize.WithCacheKeyFunction(getResourceDirectoryFoldersCacheKey))
+
+func getResourceDirectoryFoldersCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cacheKey := "ResourceDirectoryFolders"
+	return cacheKey, nil
+}
+
+// getResourceDirectoryFoldersUncached walks the folder hierarchy from the root
+// folder
+func getResourceDirectoryFoldersUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	directory, err := getResourceDirectory(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	if directory == nil {
+		return []*resourceDirectoryFolder{}, nil
+	}
+
+	client, err := ResourceManagerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	rootRequest := resourcemanager.CreateGetFolderRequest()
+	rootRequest.Scheme = "https"
+	rootRequest.FolderId = directory.RootFolderId
+
+	d.WaitForListRateLimit(ctx)
+	rootResponse, err := client.GetFolder(rootRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &resourceDirectoryFolder{
+		FolderId:   directory.RootFolderId,
+		FolderName: rootResponse.Folder.FolderName,
+		CreateTime: rootResponse.Folder.CreateTime,
+		PathIds:    []string{directory.RootFolderId},
+		PathNames:  []string{rootResponse.Folder.FolderName},
+	}
+
+	folders := []*resourceDirectoryFolder{root}
+	for i := 0; i < len(folders); i++ {
+		parent := folders[i]
+
+		request := resourcemanager.CreateListFoldersForParentRequest()
+		request.Scheme = "https"
+		request.ParentFolderId = parent.FolderId
+		request.PageSize = requests.NewInteger(100)
+		request.PageNumber = requests.NewInteger(1)
+
+		count := 0
+		for {
+			d.WaitForListRateLimit(ctx)
+			response, err := client.ListFoldersForParent(request)
+			if err != nil {
+				return nil, err
+			}
+			for _, child := range response.Folders.Folder {
+				folders = append(folders, &resourceDirectoryFolder{
+					FolderId:       child.FolderId,
+					FolderName:     child.FolderName,
+					ParentFolderId: parent.FolderId,
+					CreateTime:     child.CreateTime,
+					PathIds:        append(append([]string{}, parent.PathIds...), child.FolderId),
+					PathNames:      append(append([]string{}, parent.PathNames...), child.FolderName),
+				})
+				count++
+			}
+			if count >= response.TotalCount || len(response.Folders.Folder) == 0 {
+				break
+			}
+			request.PageNumber = requests.NewInteger(response.PageNumber + 1)
+		}
+	}
+
+	return folders, nil
+}
+
+// getResourceDirectoryFolder returns the folder with the given ID, if any
+func getResourceDirectoryFolder(ctx context.Context, d *plugin.QueryData, folderId string) (*resourceDirectoryFolder, error) {
+	folders, err := getResourceDirectoryFolders(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	for _, folder := range folders {
+		if folder.FolderId == folderId {
+			return folder, nil
+		}
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func resourceDirectoryPathToString(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	names, ok := d.Value.([]string)
+	if !ok || len(names) == 0 {
+		return nil, nil
+	}
+	return strings.Join(names, "/"), nil
+}
+
+func resourceDirectoryFolderDepth(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	folder := d.HydrateItem.(resourceDirectoryFolder)
+	return len(folder.PathIds) - 1, nil
+}
@@ -0,0 +1,205 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudResourceManagerResourceGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_resource_manager_resource_group",
+		Description: "Alibaba Cloud Resource Manager resource groups, used to group the resources of an account by project or purpose.",
+		List: &plugin.ListConfig{
+			Hydrate: listResourceManagerResourceGroups,
+			Tags:    map[string]string{"service": "resourcemanager", "action": "ListResourceGroups"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getResourceManagerResourceGroup,
+			Tags:       map[string]string{"service": "resourcemanager", "action": "GetResourceGroup"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"EntityNotExists.ResourceGroup"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getResourceManagerResourceGroupArn,
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the resource group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The unique identifier of the resource group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the resource group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Alibaba Cloud Resource Name (ARN) of the resource group.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getResourceManagerResourceGroupArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the resource group. Possible values are: Creating, OK, Deleting, PendingDelete and Deleted.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_date",
+				Description: "The time when the resource group was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "region_statuses",
+				Description: "The status of the resource group in each region.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RegionStatuses.RegionStatus"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached with the resource group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags.Tag").Transform(resourceManagerTagsSrc),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags.Tag").Transform(resourceManagerTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceManagerResourceGroupArn,
+				Transform:   transform.FromValue().Transform(ensureStringArray),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceManagerResourceGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	client, err := ResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource_manager_resource_group.listResourceManagerResourceGroups", "connection_error", err)
+		return nil, err
+	}
+
+	request := resourcemanager.CreateListResourceGroupsRequest()
+	request.Scheme = "https"
+	request.IncludeTags = requests.NewBoolean(true)
+	request.PageSize = requests.NewInteger(100)
+	request.PageNumber = requests.NewInteger(1)
+	if name := d.EqualsQualString("name"); name != "" {
+		request.Name = name
+	}
+	if status := d.EqualsQualString("status"); status != "" {
+		request.Status = status
+	}
+
+	count := 0
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.ListResourceGroups(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_resource_manager_resource_group.listResourceManagerResourceGroups", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, group := range response.ResourceGroups.ResourceGroup {
+			d.StreamListItem(ctx, group)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+			count++
+		}
+		if count >= response.TotalCount || len(response.ResourceGroups.ResourceGroup) == 0 {
+			break
+		}
+		request.PageNumber = requests.NewInteger(response.PageNumber + 1)
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getResourceManagerResourceGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	client, err := ResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource_manager_resource_group.getResourceManagerResourceGroup", "connection_error", err)
+		return nil, err
+	}
+
+	request := resourcemanager.CreateGetResourceGroupRequest()
+	request.Scheme = "https"
+	request.ResourceGroupId = id
+	request.IncludeTags = requests.NewBoolean(true)
+
+	response, err := client.GetResourceGroup(request)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_resource_manager_resource_group.getResourceManagerResourceGroup", "query_error", err, "request", request)
+		return nil, err
+	}
+	return response.ResourceGroup, nil
+}
+
+func getResourceManagerResourceGroupArn(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(resourcemanager.ResourceGroup)
+
+	return buildArn("resourcemanager", "", group.AccountId, "resourcegroup", group.Id), nil
+}
@@ -0,0 +1,312 @@
+package alicloud
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/sas"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudSecurityCenterAlert(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_security_center_alert",
+		Description: "Alicloud Security Center Alerts (suspicious events detected on assets)",
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCenterAlerts,
+			Tags:    map[string]string{"service": "sas", "action": "DescribeSuspEvents"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "occurrence_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+				{Name: "level", Require: plugin.Optional},
+				{Name: "event_status", Require: plugin.Optional},
+				{Name: "uuid", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_INT,
+				Description: "The ID of the alert.",
+			},
+			{
+				Name:        "alarm_event_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the alert.",
+			},
+			{
+				Name:        "alarm_event_name_display",
+				Type:        proto.ColumnType_STRING,
+				Description: "The display name of the alert.",
+			},
+			{
+				Name:        "alarm_event_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the alert.",
+			},
+			{
+				Name:        "alarm_event_type_display",
+				Type:        proto.ColumnType_STRING,
+				Description: "The display name of the type of the alert.",
+			},
+			{
+				Name:        "level",
+				Type:        proto.ColumnType_STRING,
+				Description: "The severity of the alert. Possible values are: serious, suspicious and remind.",
+			},
+			{
+				Name:        "event_status",
+				Type:        proto.ColumnType_INT,
+				Description: "The status of the alert. Possible values are: 1 (unhandled), 2 (ignored), 4 (confirmed), 8 (marked as false positive), 16 (handling), 32 (handled) and 64 (expired).",
+			},
+			{
+				Name:        "occurrence_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The time when the alert was first triggered.",
+				Transform:   transform.FromField("OccurrenceTimeStamp").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "last_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The time when the alert was last triggered.",
+				Transform:   transform.FromField("LastTimeStamp").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "uuid",
+				Type:        proto.ColumnType_STRING,
+				Description: "The UUID of the asset on which the alert was triggered.",
+			},
+			{
+				Name:        "instance_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the instance on which the alert was triggered.",
+			},
+			{
+				Name:        "instance_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the instance on which the alert was triggered.",
+			},
+			{
+				Name:        "internet_ip",
+				Type:        proto.ColumnType_STRING,
+				Description: "The public IP address of the instance.",
+			},
+			{
+				Name:        "intranet_ip",
+				Type:        proto.ColumnType_STRING,
+				Description: "The private IP address of the instance.",
+			},
+			{
+				Name:        "description",
+				Type:        proto.ColumnType_STRING,
+				Description: "The description of the alert.",
+				Transform:   transform.FromField("Desc"),
+			},
+			{
+				Name:        "data_source",
+				Type:        proto.ColumnType_STRING,
+				Description: "The data source of the alert.",
+			},
+			{
+				Name:        "unique_info",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique identifier of the alert.",
+			},
+			{
+				Name:        "sale_version",
+				Type:        proto.ColumnType_STRING,
+				Description: "The edition of Security Center that detected the alert.",
+			},
+			{
+				Name:        "can_be_deal_on_line",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the alert can be handled online.",
+			},
+			{
+				Name:        "auto_breaking",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the alert was automatically blocked.",
+			},
+			{
+				Name:        "operate_msg",
+				Type:        proto.ColumnType_STRING,
+				Description: "The message of the last handling operation.",
+			},
+			{
+				Name:        "operate_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The time when the alert was last handled.",
+				Transform:   transform.FromField("OperateTime").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "k8s_cluster_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the Kubernetes cluster on which the alert was triggered.",
+			},
+			{
+				Name:        "container_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the container on which the alert was triggered.",
+			},
+			{
+				Name:        "stages",
+				Type:        proto.ColumnType_JSON,
+				Description: "The attack stages of the alert.",
+				Transform:   transform.FromField("Stages").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "tactic_items",
+				Type:        proto.ColumnType_JSON,
+				Description: "The ATT&CK tactics of the alert.",
+			},
+			{
+				Name:        "details",
+				Type:        proto.ColumnType_JSON,
+				Description: "The details of the alert.",
+			},
+			{
+				Name:        "event_notes",
+				Type:        proto.ColumnType_JSON,
+				Description: "The notes of the alert.",
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("AlarmEventNameDisplay"),
+			},
+			{
+				Name:        "akas",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionAkas,
+				Hydrate:     getSecurityCenterAlertAkas,
+				Transform:   transform.FromValue(),
+			},
+
+			// Alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromMatrixItem(matrixKeyRegion),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterAlerts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	// supported regions for security center are International(cn-hangzhou), Malaysia(ap-southeast-3) and Singapore(ap-southeast-1)
+	supportedRegions := []string{"cn-hangzhou", "ap-southeast-1", "ap-southeast-3"}
+	if !slices.Contains(supportedRegions, region) {
+		return nil, nil
+	}
+
+	// Create service connection
+	client, err := SecurityCenterService(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_listSecurityCenterAlerts", "connection_error", err)
+		return nil, err
+	}
+
+	request := sas.CreateDescribeSuspEventsRequest()
+	request.Scheme = "https"
+	request.PageSize = "50"
+	request.CurrentPage = "1"
+
+	// Apply filters if provided
+	if d.EqualsQualString("level") != "" {
+		request.Levels = d.EqualsQualString("level")
+	}
+	if d.EqualsQuals["event_status"] != nil {
+		request.Status = strconv.FormatInt(d.EqualsQuals["event_status"].GetInt64Value(), 10)
+	}
+	if d.EqualsQualString("uuid") != "" {
+		request.Uuids = d.EqualsQualString("uuid")
+	}
+
+	// The API expects the time range in China Standard Time, formatted as yyyy-MM-dd HH:mm:ss
+	if d.Quals["occurrence_time"] != nil {
+		location := time.FixedZone("CST", 8*60*60)
+		for _, q := range d.Quals["occurrence_time"].Quals {
+			occurrenceTime := q.Value.GetTimestampValue().AsTime().In(location).Format(time.DateTime)
+			switch q.Operator {
+			case ">", ">=":
+				request.TimeStart = occurrenceTime
+			case "<", "<=":
+				request.TimeEnd = occurrenceTime
+			case "=":
+				request.TimeStart = occurrenceTime
+				request.TimeEnd = occurrenceTime
+			}
+		}
+	}
+
+	count := 0
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.DescribeSuspEvents(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_listSecurityCenterAlerts", "query_error", err, "request", request)
+			return nil, err
+		}
+
+		for _, event := range response.SuspEvents {
+			eventCopy := event
+			d.StreamListItem(ctx, &eventCopy)
+			count++
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		pageSize := 50
+		if len(response.SuspEvents) < pageSize || count >= response.TotalCount {
+			break
+		}
+
+		// Get current page number from response and increment
+		currentPage := response.CurrentPage + 1
+		request.CurrentPage = strconv.Itoa(currentPage)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityCenterAlertAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSecurityCenterAlertAkas")
+
+	data := h.Item.(*sas.WarningSummary)
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*alicloudCommonColumnData)
+	accountID := commonColumnData.AccountID
+
+	akas := []string{buildArn("security-center", region, accountID, "alert", strconv.FormatInt(data.Id, 10))}
+	return akas, nil
+}
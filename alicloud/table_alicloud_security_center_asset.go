@@ -258,7 +258,7 @@ func getSecurityCenterAssetAkas(ctx context.Context, d *plugin.QueryData, h *plu
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"arn:acs:security-center:" + region + ":" + accountID + ":asset/" + data.Uuid}
+	akas := []string{buildArn("security-center", region, accountID, "asset", data.Uuid)}
 	return akas, nil
 }
 
@@ -0,0 +1,225 @@
+package alicloud
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/sas"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// securityCenterBaselineCheck is a configuration check item of an asset
+type securityCenterBaselineCheck struct {
+	sas.CheckWarning
+	InstanceId   string
+	InstanceName string
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudSecurityCenterBaselineCheck(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_security_center_baseline_check",
+		Description: "Alicloud Security Center Baseline Checks (configuration risk check items per asset)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listSecurityCenterAssets,
+			Hydrate:       listSecurityCenterBaselineChecks,
+			Tags:          map[string]string{"service": "sas", "action": "DescribeCheckWarnings"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "instance_id", Require: plugin.Optional},
+				{Name: "uuid", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "check_warning_id",
+				Type:        proto.ColumnType_INT,
+				Description: "The ID of the check result of the item on the asset.",
+			},
+			{
+				Name:        "check_id",
+				Type:        proto.ColumnType_INT,
+				Description: "The ID of the check item.",
+			},
+			{
+				Name:        "item",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the check item.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the check item.",
+			},
+			{
+				Name:        "level",
+				Type:        proto.ColumnType_STRING,
+				Description: "The risk level of the check item. Possible values are: high, medium and low.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_INT,
+				Description: "The check result of the item on the asset. Possible values are: 1 (failed), 2 (verifying), 3 (passed), 5 (expired) and 6 (ignored).",
+			},
+			{
+				Name:        "passed",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the asset passed the check item.",
+				Transform:   transform.FromField("Status").Transform(securityCenterBaselineCheckPassed),
+			},
+			{
+				Name:        "fix_status",
+				Type:        proto.ColumnType_INT,
+				Description: "The fix status of the check item. Possible values are: 0 (not fixed), 1 (fixing), 2 (fixed) and 3 (fix failed).",
+			},
+			{
+				Name:        "reason",
+				Type:        proto.ColumnType_STRING,
+				Description: "The reason the asset failed the check item.",
+			},
+			{
+				Name:        "uuid",
+				Type:        proto.ColumnType_STRING,
+				Description: "The UUID of the asset.",
+			},
+			{
+				Name:        "instance_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the ECS instance.",
+			},
+			{
+				Name:        "instance_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the ECS instance.",
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Item"),
+			},
+			{
+				Name:        "akas",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionAkas,
+				Hydrate:     getSecurityCenterBaselineCheckAkas,
+				Transform:   transform.FromValue(),
+			},
+
+			// Alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromMatrixItem(matrixKeyRegion),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterBaselineChecks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// listSecurityCenterAssets only returns assets in the regions supported by security center
+	region := d.EqualsQualString(matrixKeyRegion)
+	instance := h.Item.(*sas.Instance)
+
+	if d.EqualsQualString("uuid") != "" && instance.Uuid != d.EqualsQualString("uuid") {
+		return nil, nil
+	}
+
+	// Create service connection
+	client, err := SecurityCenterService(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_listSecurityCenterBaselineChecks", "connection_error", err)
+		return nil, err
+	}
+
+	request := sas.CreateDescribeCheckWarningsRequest()
+	request.Scheme = "https"
+	request.Uuid = instance.Uuid
+	request.PageSize = requests.NewInteger(50)
+	request.CurrentPage = requests.NewInteger(1)
+
+	// Apply filters if provided
+	if d.EqualsQualString("type") != "" {
+		request.CheckType = d.EqualsQualString("type")
+	}
+
+	count := 0
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.DescribeCheckWarnings(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_listSecurityCenterBaselineChecks", "query_error", err, "request", request)
+			return nil, err
+		}
+
+		for _, warning := range response.CheckWarnings {
+			d.StreamListItem(ctx, &securityCenterBaselineCheck{
+				CheckWarning: warning,
+				InstanceId:   instance.InstanceId,
+				InstanceName: instance.InstanceName,
+			})
+			count++
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		pageSize := 50
+		if len(response.CheckWarnings) < pageSize || count >= response.TotalCount {
+			break
+		}
+
+		// Get current page number from response and increment
+		currentPage := response.CurrentPage + 1
+		request.CurrentPage = requests.NewInteger(currentPage)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityCenterBaselineCheckAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSecurityCenterBaselineCheckAkas")
+
+	data := h.Item.(*securityCenterBaselineCheck)
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*alicloudCommonColumnData)
+	accountID := commonColumnData.AccountID
+
+	akas := []string{buildArn("security-center", region, accountID, "baseline-check", strconv.FormatInt(data.CheckWarningId, 10))}
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func securityCenterBaselineCheckPassed(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	status, ok := d.Value.(int)
+	if !ok {
+		return nil, nil
+	}
+	return status == 3, nil
+}
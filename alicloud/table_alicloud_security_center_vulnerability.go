@@ -337,7 +337,7 @@ func getSecurityCenterVulnerabilityAkas(ctx context.Context, d *plugin.QueryData
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"arn:acs:security-center:" + region + ":" + accountID + ":vulnerability/" + strconv.FormatInt(data.RecordId, 10)}
+	akas := []string{buildArn("security-center", region, accountID, "vulnerability", strconv.FormatInt(data.RecordId, 10))}
 	return akas, nil
 }
 
@@ -53,6 +53,13 @@ func tableAlicloudSlbLoadBalancer(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "The ID of the SLB instance.",
 			},
+			{
+				Name:        "arn",
+				Type:        proto.ColumnType_STRING,
+				Description: "The Alibaba Cloud Resource Name (ARN) of the SLB instance.",
+				Hydrate:     getSlbLoadBalancerARN,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "load_balancer_status",
 				Type:        proto.ColumnType_STRING,
@@ -176,6 +183,13 @@ func tableAlicloudSlbLoadBalancer(ctx context.Context) *plugin.Table {
 				Description: ColumnDescriptionTags,
 				Transform:   transform.From(slbLoadbalancerTagMap),
 			},
+			{
+				Name:        "akas",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionAkas,
+				Hydrate:     getSlbLoadBalancerARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
 
 			// Alicloud standard columns
 			{
@@ -330,6 +344,22 @@ func getSlbLoadBalancer(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	return nil, nil
 }
 
+func getSlbLoadBalancerARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSlbLoadBalancerARN")
+	data := h.Item.(slb.LoadBalancer)
+
+	// Get project details
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*alicloudCommonColumnData)
+	accountID := commonColumnData.AccountID
+
+	return buildArn("slb", data.RegionId, accountID, "loadbalancer", data.LoadBalancerId), nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func slbLoadbalancerTagMap(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -235,6 +235,6 @@ func getSLSAlertAkas(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:log:" + data.Region + ":" + accountID + ":project/" + data.Project + "/alert/" + data.Alert.Name}
+	akas := []string{buildArn("log", data.Region, accountID, "project", data.Project+"/alert/"+data.Alert.Name)}
 	return akas, nil
 }
@@ -0,0 +1,177 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/tag"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudTagKey(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_tag_key",
+		Description: "Alibaba Cloud tag keys in use across all services, with their values.",
+		List: &plugin.ListConfig{
+			Hydrate: listTagKeys,
+			Tags:    map[string]string{"service": "tag", "action": "ListTagKeys"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "category", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: listTagKeyValues,
+				Tags: map[string]string{"service": "tag", "action": "ListTagValues"},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "key",
+				Description: "The tag key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category",
+				Description: "The category of the tag key. Possible values are: custom and system.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the tag key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value_count",
+				Description: "The number of distinct values of the tag key.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     listTagKeyValues,
+				Transform:   transform.FromValue().Transform(tagKeyValueCount),
+			},
+			{
+				Name:        "values",
+				Description: "The distinct values of the tag key.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTagKeyValues,
+				Transform:   transform.FromValue(),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromMatrixItem(matrixKeyRegion),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listTagKeys(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	// Create service connection
+	client, err := TagService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_tag_key.listTagKeys", "connection_error", err)
+		return nil, err
+	}
+
+	request := tag.CreateListTagKeysRequest()
+	request.Scheme = "https"
+	request.RegionId = region
+	request.PageSize = requests.NewInteger(1000)
+	if category := d.EqualsQualString("category"); category != "" {
+		request.Category = category
+	}
+
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.ListTagKeys(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_tag_key.listTagKeys", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, key := range response.Keys.Key {
+			d.StreamListItem(ctx, key)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.NextToken == "" {
+			break
+		}
+		request.NextToken = response.NextToken
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listTagKeyValues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	key := h.Item.(tag.Key)
+
+	// Create service connection
+	client, err := TagService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_tag_key.listTagKeyValues", "connection_error", err)
+		return nil, err
+	}
+
+	request := tag.CreateListTagValuesRequest()
+	request.Scheme = "https"
+	request.RegionId = region
+	request.Key = key.Key
+	request.PageSize = requests.NewInteger(1000)
+
+	values := []string{}
+	for {
+		response, err := client.ListTagValues(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_tag_key.listTagKeyValues", "query_error", err, "request", request)
+			return nil, err
+		}
+		values = append(values, response.Values.Value...)
+		if response.NextToken == "" {
+			break
+		}
+		request.NextToken = response.NextToken
+	}
+	return values, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func tagKeyValueCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	values, ok := d.Value.([]string)
+	if !ok {
+		return nil, nil
+	}
+	return len(values), nil
+}
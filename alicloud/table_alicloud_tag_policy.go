@@ -0,0 +1,220 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/tag"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudTagPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_tag_policy",
+		Description: "Alibaba Cloud tag policies, which define the tag keys and values resources must use.",
+		List: &plugin.ListConfig{
+			Hydrate: listTagPolicies,
+			Tags:    map[string]string{"service": "tag", "action": "ListPolicies"},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("policy_id"),
+			Hydrate:    getTagPolicy,
+			Tags:       map[string]string{"service": "tag", "action": "GetPolicy"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getTagPolicy,
+				Tags: map[string]string{"service": "tag", "action": "GetPolicy"},
+			},
+			{
+				Func: listTagPolicyTargets,
+				Tags: map[string]string{"service": "tag", "action": "ListTargetsForPolicy"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "policy_id",
+				Description: "The ID of the tag policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_name",
+				Description: "The name of the tag policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the tag policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyDesc"),
+			},
+			{
+				Name:        "user_type",
+				Description: "The mode of the tag policy. Possible values are: USER for single-account mode and RD for multi-account mode.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_content",
+				Description: "The document of the tag policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getTagPolicy,
+				Transform:   transform.FromField("PolicyContent").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "targets",
+				Description: "The objects the tag policy is attached to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTagPolicyTargets,
+				Transform:   transform.FromValue(),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyName"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listTagPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	policies, err := getTagPolicies(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_tag_policy.listTagPolicies", "query_error", err)
+		return nil, err
+	}
+
+	for _, policy := range policies {
+		d.StreamListItem(ctx, policy)
+		// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getTagPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = h.Item.(tag.Policy).PolicyId
+	} else {
+		id = d.EqualsQualString("policy_id")
+	}
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	client, err := TagPolicyService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_tag_policy.getTagPolicy", "connection_error", err)
+		return nil, err
+	}
+
+	request := tag.CreateGetPolicyRequest()
+	request.Scheme = "https"
+	request.PolicyId = id
+
+	response, err := client.GetPolicy(request)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_tag_policy.getTagPolicy", "query_error", err, "request", request)
+		return nil, err
+	}
+	if response.Policy.PolicyId == "" {
+		return nil, nil
+	}
+	return response.Policy, nil
+}
+
+func listTagPolicyTargets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policy := h.Item.(tag.Policy)
+
+	targets, err := getTagPolicyTargets(ctx, d, policy.PolicyId)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_tag_policy.listTagPolicyTargets", "query_error", err, "policy_id", policy.PolicyId)
+		return nil, err
+	}
+	return targets, nil
+}
+
+// getTagPolicies returns the tag policies of the account
+func getTagPolicies(ctx context.Context, d *plugin.QueryData) ([]tag.Policy, error) {
+	client, err := TagPolicyService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	request := tag.CreateListPoliciesRequest()
+	request.Scheme = "https"
+	request.MaxResult = requests.NewInteger(100)
+
+	policies := []tag.Policy{}
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.ListPolicies(request)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, response.PolicyList...)
+		if response.NextToken == "" {
+			break
+		}
+		request.NextToken = response.NextToken
+	}
+	return policies, nil
+}
+
+// getTagPolicyTargets returns the objects a tag policy is attached to
+func getTagPolicyTargets(ctx context.Context, d *plugin.QueryData, policyId string) ([]tag.Target, error) {
+	client, err := TagPolicyService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	request := tag.CreateListTargetsForPolicyRequest()
+	request.Scheme = "https"
+	request.PolicyId = policyId
+	request.MaxResult = requests.NewInteger(100)
+
+	targets := []tag.Target{}
+	for {
+		response, err := client.ListTargetsForPolicy(request)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, response.Targets...)
+		if response.NextToken == "" {
+			break
+		}
+		request.NextToken = response.NextToken
+	}
+	return targets, nil
+}
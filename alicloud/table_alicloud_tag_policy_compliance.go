@@ -0,0 +1,290 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/tag"
+	"github.com/turbot/go-kit/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// tagPolicyComplianceRow is a tag key of a resource that does not comply
+// with a tag policy
+type tagPolicyComplianceRow struct {
+	PolicyId      string
+	PolicyName    string
+	Arn           string
+	ResourceId    string
+	ResourceName  string
+	ResourceType  string
+	Region        string
+	AccountId     string
+	TagKey        string
+	TagValue      *string
+	AllowedValues []string
+	Issue         string
+	Tags          map[string]string
+}
+
+// tagPolicyEvaluation is a tag policy in effect along with its parsed document
+type tagPolicyEvaluation struct {
+	Policy   tag.Policy
+	Document *tagPolicyDocument
+}
+
+//// TABLE DEFINITION
+
+func tableAlicloudTagPolicyCompliance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_tag_policy_compliance",
+		Description: "Alibaba Cloud resource tags that do not comply with the tag policies in effect for the account, one row per non-compliant key per resource.",
+		List: &plugin.ListConfig{
+			Hydrate: listTagPolicyCompliance,
+			Tags:    map[string]string{"service": "tag", "action": "ListPolicies"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "region", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Alibaba Cloud Resource Name (ARN) of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource in its ARN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the resource, or its ID if it has no name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource, which is the name of the table of the resource without the alicloud_ prefix, such as ecs_instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_id",
+				Description: "The ID of the tag policy the resource does not comply with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_name",
+				Description: "The name of the tag policy the resource does not comply with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tag_key",
+				Description: "The non-compliant tag key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tag_value",
+				Description: "The value of the non-compliant tag on the resource. Null if the key is missing.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "allowed_values",
+				Description: "The values allowed by the tag policy for the key.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "issue",
+				Description: "Why the tag does not comply. Possible values are: MissingRequiredKey, InvalidKeyCase and InvalidValue.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// steampipe common columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceId"),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listTagPolicyCompliance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	evaluations, err := getTagPolicyEvaluations(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_tag_policy_compliance.listTagPolicyCompliance", "query_error", err)
+		return nil, err
+	}
+	if len(evaluations) == 0 {
+		return nil, nil
+	}
+
+	// The resources and their tags are read from the resource tables which
+	// have a tags column, as the tags of the other tables are unknown
+	resourceType := d.EqualsQualString("resource_type")
+	var tables []*plugin.Table
+	for _, table := range getResourceTables(d.Table.Plugin) {
+		if (resourceType == "" || getResourceType(table) == resourceType) && getTableColumn(table, "tags") != nil {
+			tables = append(tables, table)
+		}
+	}
+
+	var lock sync.Mutex
+	err = listResourceTables(ctx, d, tables, d.EqualsQualString("region"), func(ctx context.Context, item resourceTableItem) error {
+		resource, err := getResourceInfo(ctx, d, item)
+		if err != nil {
+			return err
+		}
+		tags, err := item.tags(ctx)
+		if err != nil {
+			return err
+		}
+		resourceTags := tagPolicyResourceTags(tags)
+		policyResourceType := tagPolicyResourceType(resource.Arn)
+
+		lock.Lock()
+		defer lock.Unlock()
+		for _, evaluation := range evaluations {
+			for _, violation := range evaluateTagPolicy(evaluation.Document, policyResourceType, resourceTags) {
+				d.StreamListItem(ctx, tagPolicyComplianceRow{
+					PolicyId:      evaluation.Policy.PolicyId,
+					PolicyName:    evaluation.Policy.PolicyName,
+					Arn:           resource.Arn,
+					ResourceId:    resource.ResourceId,
+					ResourceName:  resource.Name,
+					ResourceType:  resource.ResourceType,
+					Region:        resource.Region,
+					AccountId:     resource.AccountId,
+					TagKey:        violation.TagKey,
+					TagValue:      violation.TagValue,
+					AllowedValues: violation.AllowedValues,
+					Issue:         violation.Issue,
+					Tags:          resourceTags,
+				})
+				// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_tag_policy_compliance.listTagPolicyCompliance", "query_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getTagPolicyEvaluations returns the tag policies in effect for the account
+// of the connection, with their parsed documents
+func getTagPolicyEvaluations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) ([]tagPolicyEvaluation, error) {
+	policies, err := getTagPolicies(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	accountIdData, err := getAccountId(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	accountId := types.SafeString(accountIdData)
+	directoryPath, err := getTagPolicyDirectoryPath(ctx, d, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := TagPolicyService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluations := []tagPolicyEvaluation{}
+	for _, policy := range policies {
+		targets, err := getTagPolicyTargets(ctx, d, policy.PolicyId)
+		if err != nil {
+			return nil, err
+		}
+		if !tagPolicyAppliesToAccount(targets, accountId, directoryPath) {
+			continue
+		}
+
+		if policy.PolicyContent == "" {
+			request := tag.CreateGetPolicyRequest()
+			request.Scheme = "https"
+			request.PolicyId = policy.PolicyId
+
+			response, err := client.GetPolicy(request)
+			if err != nil {
+				return nil, err
+			}
+			policy.PolicyContent = response.Policy.PolicyContent
+		}
+
+		// A policy which cannot be evaluated fails the query, as skipping it
+		// would report its resources as compliant
+		document, err := parseTagPolicyDocument(policy.PolicyContent)
+		if err != nil {
+			return nil, fmt.Errorf("invalid content of tag policy %s: %w", policy.PolicyId, err)
+		}
+		evaluations = append(evaluations, tagPolicyEvaluation{Policy: policy, Document: document})
+	}
+	return evaluations, nil
+}
+
+// getTagPolicyDirectoryPath returns the path of an account in its resource
+// directory. Accounts which are not members of a resource directory have no
+// path, and only the policies attached to the account itself are in effect
+// for them. Other errors fail the query, unless they are ignored by the
+// ignore_error_codes config argument.
+func getTagPolicyDirectoryPath(ctx context.Context, d *plugin.QueryData, accountId string) (string, error) {
+	client, err := ResourceManagerService(ctx, d)
+	if err != nil {
+		return "", err
+	}
+
+	request := resourcemanager.CreateGetAccountRequest()
+	request.Scheme = "https"
+	request.AccountId = accountId
+
+	response, err := client.GetAccount(request)
+	if err != nil {
+		if isNotFoundError([]string{"EntityNotExists.ResourceDirectory", "EntityNotExists.Account"})(ctx, d, nil, err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return response.Account.ResourceDirectoryPath, nil
+}
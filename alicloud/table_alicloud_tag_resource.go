@@ -0,0 +1,184 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/tag"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAlicloudTagResource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "alicloud_tag_resource",
+		Description: "Alibaba Cloud tagged resources across all services, as returned by the Tag API.",
+		List: &plugin.ListConfig{
+			Hydrate: listTagResources,
+			Tags:    map[string]string{"service": "tag", "action": "ListTagResources"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "category", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "resource_arn",
+				Description: "The Alibaba Cloud Resource Name (ARN) of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceARN"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceARN").Transform(tagResourceIdFromArn),
+			},
+			{
+				Name:        "service",
+				Description: "The service the resource belongs to, such as ecs or vpc.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceARN").Transform(tagResourceServiceFromArn),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource within its service, such as instance or vpc.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceARN").Transform(tagResourceTypeFromArn),
+			},
+			{
+				Name:        "category",
+				Description: "The category of the tags to return. Possible values are: custom (default), system and all.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("category"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// steampipe common columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(tagResourceTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceARN").Transform(tagResourceIdFromArn),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceARN").Transform(ensureStringArray),
+			},
+
+			// alicloud standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromMatrixItem(matrixKeyRegion),
+			},
+			{
+				Name:        "account_id",
+				Description: ColumnDescriptionAccount,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.FromField("AccountID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listTagResources(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	// Create service connection
+	client, err := TagService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("alicloud_tag_resource.listTagResources", "connection_error", err)
+		return nil, err
+	}
+
+	request := tag.CreateListTagResourcesRequest()
+	request.Scheme = "https"
+	request.RegionId = region
+	request.PageSize = requests.NewInteger(1000)
+	if category := d.EqualsQualString("category"); category != "" {
+		request.Category = category
+	}
+
+	for {
+		d.WaitForListRateLimit(ctx)
+		response, err := client.ListTagResources(request)
+		if err != nil {
+			plugin.Logger(ctx).Error("alicloud_tag_resource.listTagResources", "query_error", err, "request", request)
+			return nil, err
+		}
+		for _, resource := range response.TagResources {
+			d.StreamListItem(ctx, resource)
+			// This will return zero if context has been cancelled (i.e due to manual cancellation) or
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.NextToken == "" {
+			break
+		}
+		request.NextToken = response.NextToken
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func tagResourceIdFromArn(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	arn, err := parseArn(d.Value.(string))
+	if err != nil {
+		return nil, nil
+	}
+	return arn.ResourceId, nil
+}
+
+func tagResourceServiceFromArn(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	arn, err := parseArn(d.Value.(string))
+	if err != nil {
+		return nil, nil
+	}
+	return arn.Service, nil
+}
+
+func tagResourceTypeFromArn(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	arn, err := parseArn(d.Value.(string))
+	if err != nil {
+		return nil, nil
+	}
+	return arn.ResourceType, nil
+}
+
+func tagResourceTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]tag.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[i.Key] = i.Value
+	}
+	return turbotTagsMap, nil
+}
@@ -350,7 +350,7 @@ func getVpcAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 
 func vpcArn(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	i := d.HydrateItem.(vpc.Vpc)
-	return "acs:vpc:" + i.RegionId + ":" + strconv.FormatInt(i.OwnerId, 10) + ":vpc/" + i.VpcId, nil
+	return buildArn("vpc", i.RegionId, strconv.FormatInt(i.OwnerId, 10), "vpc", i.VpcId), nil
 }
 
 func vpcTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -247,7 +247,7 @@ func getVpcDhcpOptionSetAka(ctx context.Context, d *plugin.QueryData, h *plugin.
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:vpc:" + region + ":" + accountID + ":dhcpoptionset/" + id}
+	akas := []string{buildArn("vpc", region, accountID, "dhcpoptionset", id)}
 
 	return akas, nil
 }
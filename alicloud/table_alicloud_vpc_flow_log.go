@@ -228,7 +228,7 @@ func getVpcFlowLogAka(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:vpc:" + region + ":" + accountID + ":flowlog/" + data.FlowLogId}
+	akas := []string{buildArn("vpc", region, accountID, "flowlog", data.FlowLogId)}
 
 	return akas, nil
 }
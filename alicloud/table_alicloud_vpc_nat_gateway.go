@@ -249,7 +249,7 @@ func getVpcNatGatewayAka(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:vpc:" + ngw.RegionId + ":" + accountID + ":natgateway/" + ngw.NatGatewayId}
+	akas := []string{buildArn("vpc", ngw.RegionId, accountID, "natgateway", ngw.NatGatewayId)}
 
 	return akas, nil
 }
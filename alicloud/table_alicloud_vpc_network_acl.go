@@ -217,7 +217,7 @@ func getNetworkACLAka(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:vpc:" + region + ":" + accountID + ":network-acl/" + data["ID"]}
+	akas := []string{buildArn("vpc", region, accountID, "network-acl", data["ID"])}
 
 	return akas, nil
 }
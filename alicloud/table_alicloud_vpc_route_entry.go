@@ -186,10 +186,10 @@ func getVpcRouteEntryTurbotData(ctx context.Context, d *plugin.QueryData, h *plu
 	var title string
 	var akas []string
 	if len(data.RouteEntryId) > 0 {
-		akas = []string{"acs:vpc:" + region + ":" + accountID + ":route-entry/" + data.RouteEntryId}
+		akas = []string{buildArn("vpc", region, accountID, "route-entry", data.RouteEntryId)}
 		title = data.RouteEntryName
 	} else {
-		akas = []string{"acs:vpc:" + region + ":" + accountID + ":route-entry/" + data.RouteTableId}
+		akas = []string{buildArn("vpc", region, accountID, "route-entry", data.RouteTableId)}
 		if len(data.NextHops.NextHop[0].NextHopId) > 0 {
 			title = data.RouteTableId + ":" + data.DestinationCidrBlock + ":" + data.NextHops.NextHop[0].NextHopType + ":" + data.NextHops.NextHop[0].NextHopId
 		} else {
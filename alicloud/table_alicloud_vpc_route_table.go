@@ -255,7 +255,7 @@ func getVpcRouteTableAka(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:vpc:" + region + ":" + accountID + ":route-table/" + data.RouteTableId}
+	akas := []string{buildArn("vpc", region, accountID, "route-table", data.RouteTableId)}
 
 	return akas, nil
 }
@@ -217,7 +217,7 @@ func getVpcSslVpnClientCertCertAka(ctx context.Context, d *plugin.QueryData, h *
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:vpc:" + data.Region + ":" + accountID + ":sslclientcert/" + data.SslVpnClientCertId}
+	akas := []string{buildArn("vpc", data.Region, accountID, "sslclientcert", data.SslVpnClientCertId)}
 
 	return akas, nil
 }
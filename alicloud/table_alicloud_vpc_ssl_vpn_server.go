@@ -225,7 +225,7 @@ func getVpnSslServerAka(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"arn:acs:ecs:" + sslServer.RegionId + ":" + accountID + ":sslVpnServer/" + sslServer.SslVpnServerId}
+	akas := []string{buildArn("vpc", sslServer.RegionId, accountID, "sslvpnserver", sslServer.SslVpnServerId)}
 
 	return akas, nil
 }
@@ -242,7 +242,7 @@ func getVpnConnectionAka(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"arn:acs:vpc:" + region + ":" + accountID + ":vpnconnection/" + data.VpnConnectionId}
+	akas := []string{buildArn("vpc", region, accountID, "vpnconnection", data.VpnConnectionId)}
 
 	return akas, nil
 }
@@ -175,7 +175,7 @@ func getVpcCustomerGatewayAka(ctx context.Context, d *plugin.QueryData, h *plugi
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:vpc:" + region + ":" + accountID + ":customergateway/" + data.CustomerGatewayId}
+	akas := []string{buildArn("vpc", region, accountID, "customergateway", data.CustomerGatewayId)}
 
 	return akas, nil
 }
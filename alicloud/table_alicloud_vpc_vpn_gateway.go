@@ -255,7 +255,7 @@ func getVpcVpnGatewayAka(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 	commonColumnData := commonData.(*alicloudCommonColumnData)
 	accountID := commonColumnData.AccountID
 
-	akas := []string{"acs:vpc:" + region + ":" + accountID + ":vpngateway/" + data.VpnGatewayId}
+	akas := []string{buildArn("vpc", region, accountID, "vpngateway", data.VpnGatewayId)}
 
 	return akas, nil
 }
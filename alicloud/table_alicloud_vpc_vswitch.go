@@ -239,7 +239,8 @@ func getVSwitchAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 
 func vswitchAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	i := d.HydrateItem.(vpc.VSwitch)
-	return []string{"acs:vswitch:" + i.ZoneId + ":" + strconv.FormatInt(i.OwnerId, 10) + ":vswitch/" + i.VSwitchId}, nil
+	region, _ := d.MatrixItem[matrixKeyRegion].(string)
+	return []string{buildArn("vpc", region, strconv.FormatInt(i.OwnerId, 10), "vswitch", i.VSwitchId)}, nil
 }
 
 func vswitchTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -0,0 +1,160 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/tag"
+	"github.com/turbot/go-kit/types"
+)
+
+// Reasons a tag on a resource does not comply with a tag policy
+const (
+	tagPolicyIssueMissingKey = "MissingRequiredKey"
+	tagPolicyIssueKeyCase    = "InvalidKeyCase"
+	tagPolicyIssueValue      = "InvalidValue"
+)
+
+// tagPolicyDocument is the content of a tag policy, e.g.
+//
+//	{"tags": {"CostCenter": {
+//	  "tag_key": {"@@assign": "CostCenter"},
+//	  "tag_value": {"@@assign": ["Beijing", "Shanghai"]},
+//	  "report_required_for": {"@@assign": ["ecs:instance"]}}}}
+type tagPolicyDocument struct {
+	Tags map[string]tagPolicyRule `json:"tags"`
+}
+
+type tagPolicyRule struct {
+	TagKey struct {
+		Assign string `json:"@@assign"`
+	} `json:"tag_key"`
+	TagValue struct {
+		Assign []string `json:"@@assign"`
+	} `json:"tag_value"`
+	ReportRequiredFor struct {
+		Assign []string `json:"@@assign"`
+	} `json:"report_required_for"`
+}
+
+// tagPolicyViolation is a tag key of a resource that does not comply with a rule
+type tagPolicyViolation struct {
+	TagKey        string
+	TagValue      *string
+	AllowedValues []string
+	Issue         string
+}
+
+func parseTagPolicyDocument(content string) (*tagPolicyDocument, error) {
+	var document tagPolicyDocument
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+// evaluateTagPolicy returns the tag keys of a resource that do not comply with
+// the policy. resourceType is in the policy form, e.g. ecs:instance.
+func evaluateTagPolicy(document *tagPolicyDocument, resourceType string, tags map[string]string) []tagPolicyViolation {
+	violations := []tagPolicyViolation{}
+	for name, rule := range document.Tags {
+		key := rule.TagKey.Assign
+		if key == "" {
+			key = name
+		}
+
+		value, found := tags[key]
+		if !found {
+			// A key that only differs in case does not comply either
+			for k, v := range tags {
+				if strings.EqualFold(k, key) {
+					tagValue := v
+					violations = append(violations, tagPolicyViolation{TagKey: k, TagValue: &tagValue, AllowedValues: rule.TagValue.Assign, Issue: tagPolicyIssueKeyCase})
+					found = true
+					break
+				}
+			}
+			if !found && tagPolicyResourceTypeMatches(rule.ReportRequiredFor.Assign, resourceType) {
+				violations = append(violations, tagPolicyViolation{TagKey: key, AllowedValues: rule.TagValue.Assign, Issue: tagPolicyIssueMissingKey})
+			}
+			continue
+		}
+
+		if len(rule.TagValue.Assign) > 0 && !tagPolicyValueAllowed(rule.TagValue.Assign, value) {
+			violations = append(violations, tagPolicyViolation{TagKey: key, TagValue: &value, AllowedValues: rule.TagValue.Assign, Issue: tagPolicyIssueValue})
+		}
+	}
+	return violations
+}
+
+// tagPolicyValueAllowed checks a value against the allowed values of a rule,
+// where a trailing * matches any suffix
+func tagPolicyValueAllowed(allowed []string, value string) bool {
+	for _, a := range allowed {
+		if prefix, ok := strings.CutSuffix(a, "*"); ok {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+		} else if a == value {
+			return true
+		}
+	}
+	return false
+}
+
+// tagPolicyResourceTypeMatches checks a resource type against the resource
+// types of a rule, where <service>:* matches every type of the service
+func tagPolicyResourceTypeMatches(types []string, resourceType string) bool {
+	service, _, _ := strings.Cut(resourceType, ":")
+	for _, t := range types {
+		t = strings.ToLower(t)
+		if t == resourceType || t == "*" || t == service+":*" {
+			return true
+		}
+	}
+	return false
+}
+
+// tagPolicyResourceType returns the resource type of a resource in the form
+// used in tag policies, e.g. ecs:instance, from the service and resource type
+// of its ARN. It returns an empty string if the ARN cannot be parsed.
+func tagPolicyResourceType(arn string) string {
+	parsed, err := parseArn(arn)
+	if err != nil || parsed.ResourceType == "" {
+		return ""
+	}
+	return strings.ToLower(parsed.Service + ":" + parsed.ResourceType)
+}
+
+// tagPolicyAppliesToAccount checks whether a tag policy attached to targets is
+// in effect for an account. A policy applies to the account it is attached
+// to, and to the accounts under the root or folder of a resource directory it
+// is attached to, which are in the path of the account in the directory, e.g.
+// rd-3G****/r-Wm****/fd-bVaRIG****/180****.
+func tagPolicyAppliesToAccount(targets []tag.Target, accountId string, directoryPath string) bool {
+	ancestors := strings.Split(directoryPath, "/")
+	for _, target := range targets {
+		if target.TargetId == accountId || (directoryPath != "" && slices.Contains(ancestors, target.TargetId)) {
+			return true
+		}
+	}
+	return false
+}
+
+// tagPolicyResourceTags converts the tags column of a resource table, which is
+// a map of the tag keys to their values, to the tags evaluated by policies
+func tagPolicyResourceTags(tags interface{}) map[string]string {
+	resourceTags := map[string]string{}
+	switch tags := tags.(type) {
+	case map[string]string:
+		for k, v := range tags {
+			resourceTags[k] = v
+		}
+	case map[string]interface{}:
+		for k, v := range tags {
+			resourceTags[k] = types.ToString(v)
+		}
+	}
+	return resourceTags
+}
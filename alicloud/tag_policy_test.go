@@ -0,0 +1,189 @@
+package alicloud
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/tag"
+)
+
+const testTagPolicyContent = `{"tags": {
+	"CostCenter": {
+		"tag_key": {"@@assign": "CostCenter"},
+		"tag_value": {"@@assign": ["Beijing", "Shanghai*"]},
+		"report_required_for": {"@@assign": ["ecs:instance", "oss:*"]}
+	},
+	"owner": {
+		"tag_key": {"@@assign": "Owner"}
+	}
+}}`
+
+func TestEvaluateTagPolicy(t *testing.T) {
+	document, err := parseTagPolicyDocument(testTagPolicyContent)
+	if err != nil {
+		t.Fatalf("parseTagPolicyDocument() error = %v", err)
+	}
+	allowed := []string{"Beijing", "Shanghai*"}
+	value := func(v string) *string { return &v }
+
+	tests := []struct {
+		name         string
+		resourceType string
+		tags         map[string]string
+		want         []tagPolicyViolation
+	}{
+		{"compliant", "ecs:instance", map[string]string{"CostCenter": "Beijing", "Owner": "alice"}, []tagPolicyViolation{}},
+		{"value with allowed prefix", "ecs:instance", map[string]string{"CostCenter": "Shanghai-1"}, []tagPolicyViolation{}},
+		{"missing required key", "ecs:instance", map[string]string{}, []tagPolicyViolation{
+			{TagKey: "CostCenter", AllowedValues: allowed, Issue: tagPolicyIssueMissingKey},
+		}},
+		{"missing required key of a service", "oss:bucket", nil, []tagPolicyViolation{
+			{TagKey: "CostCenter", AllowedValues: allowed, Issue: tagPolicyIssueMissingKey},
+		}},
+		{"missing key not required for the type", "ecs:disk", map[string]string{}, []tagPolicyViolation{}},
+		{"key case", "ecs:disk", map[string]string{"costcenter": "Beijing"}, []tagPolicyViolation{
+			{TagKey: "costcenter", TagValue: value("Beijing"), AllowedValues: allowed, Issue: tagPolicyIssueKeyCase},
+		}},
+		{"value not allowed", "ecs:disk", map[string]string{"CostCenter": "Hangzhou"}, []tagPolicyViolation{
+			{TagKey: "CostCenter", TagValue: value("Hangzhou"), AllowedValues: allowed, Issue: tagPolicyIssueValue},
+		}},
+		{"key without allowed values", "ecs:instance", map[string]string{"CostCenter": "Beijing", "owner": "alice"}, []tagPolicyViolation{
+			{TagKey: "owner", TagValue: value("alice"), Issue: tagPolicyIssueKeyCase},
+		}},
+		{"several violations", "ecs:instance", map[string]string{"CostCenter": "Hangzhou", "OWNER": "alice"}, []tagPolicyViolation{
+			{TagKey: "CostCenter", TagValue: value("Hangzhou"), AllowedValues: allowed, Issue: tagPolicyIssueValue},
+			{TagKey: "OWNER", TagValue: value("alice"), Issue: tagPolicyIssueKeyCase},
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := evaluateTagPolicy(document, test.resourceType, test.tags)
+			// The rules of a policy are evaluated in no particular order
+			slices.SortFunc(got, func(a, b tagPolicyViolation) int {
+				return strings.Compare(a.TagKey, b.TagKey)
+			})
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("evaluateTagPolicy() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseTagPolicyDocumentInvalid(t *testing.T) {
+	if _, err := parseTagPolicyDocument(`{"tags": [`); err == nil {
+		t.Error("parseTagPolicyDocument() returned no error")
+	}
+}
+
+func TestTagPolicyValueAllowed(t *testing.T) {
+	tests := []struct {
+		allowed []string
+		value   string
+		want    bool
+	}{
+		{[]string{"Beijing", "Shanghai"}, "Beijing", true},
+		{[]string{"Beijing", "Shanghai"}, "beijing", false},
+		{[]string{"Beijing", "Shanghai"}, "Beijing-1", false},
+		{[]string{"Bei*"}, "Beijing", true},
+		{[]string{"Bei*"}, "Bei", true},
+		{[]string{"Bei*"}, "Shanghai", false},
+		{[]string{"*"}, "", true},
+		{[]string{}, "Beijing", false},
+	}
+	for _, test := range tests {
+		if got := tagPolicyValueAllowed(test.allowed, test.value); got != test.want {
+			t.Errorf("tagPolicyValueAllowed(%v, %q) = %v, want %v", test.allowed, test.value, got, test.want)
+		}
+	}
+}
+
+func TestTagPolicyResourceTypeMatches(t *testing.T) {
+	tests := []struct {
+		types        []string
+		resourceType string
+		want         bool
+	}{
+		{[]string{"ecs:instance"}, "ecs:instance", true},
+		{[]string{"ECS:Instance"}, "ecs:instance", true},
+		{[]string{"ecs:disk", "ecs:instance"}, "ecs:instance", true},
+		{[]string{"ecs:*"}, "ecs:instance", true},
+		{[]string{"*"}, "oss:bucket", true},
+		{[]string{"ecs:disk"}, "ecs:instance", false},
+		{[]string{"vpc:*"}, "ecs:instance", false},
+		{[]string{"ecs:instance"}, "", false},
+		{nil, "ecs:instance", false},
+	}
+	for _, test := range tests {
+		if got := tagPolicyResourceTypeMatches(test.types, test.resourceType); got != test.want {
+			t.Errorf("tagPolicyResourceTypeMatches(%v, %q) = %v, want %v", test.types, test.resourceType, got, test.want)
+		}
+	}
+}
+
+func TestTagPolicyResourceType(t *testing.T) {
+	tests := []struct {
+		arn  string
+		want string
+	}{
+		{"acs:ecs:cn-hangzhou:123:instance/i-1", "ecs:instance"},
+		{"acs:ecs:cn-hangzhou:123:securitygroup/sg-1", "ecs:securitygroup"},
+		{"acs:oss:cn-hangzhou:123:my-bucket", "oss:bucket"},
+		{"acs:ram::123:user/alice", "ram:user"},
+		{"acs:ram::123:root", ""},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if got := tagPolicyResourceType(test.arn); got != test.want {
+			t.Errorf("tagPolicyResourceType(%q) = %q, want %q", test.arn, got, test.want)
+		}
+	}
+}
+
+func TestTagPolicyAppliesToAccount(t *testing.T) {
+	const path = "rd-3G1/r-Wm1/fd-bVa1/123"
+
+	tests := []struct {
+		name          string
+		targets       []tag.Target
+		directoryPath string
+		want          bool
+	}{
+		{"account", []tag.Target{{TargetId: "123"}}, "", true},
+		{"member account", []tag.Target{{TargetId: "123"}}, path, true},
+		{"root", []tag.Target{{TargetId: "r-Wm1"}}, path, true},
+		{"folder of the account", []tag.Target{{TargetId: "fd-bVa1"}}, path, true},
+		{"other folder", []tag.Target{{TargetId: "fd-other"}}, path, false},
+		{"other account", []tag.Target{{TargetId: "456"}}, path, false},
+		{"folder without directory path", []tag.Target{{TargetId: "fd-bVa1"}}, "", false},
+		{"one of the targets", []tag.Target{{TargetId: "456"}, {TargetId: "fd-bVa1"}}, path, true},
+		{"no targets", nil, path, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := tagPolicyAppliesToAccount(test.targets, "123", test.directoryPath); got != test.want {
+				t.Errorf("tagPolicyAppliesToAccount() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTagPolicyResourceTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags interface{}
+		want map[string]string
+	}{
+		{"string values", map[string]string{"Owner": "alice"}, map[string]string{"Owner": "alice"}},
+		{"interface values", map[string]interface{}{"Owner": "alice", "Count": 1}, map[string]string{"Owner": "alice", "Count": "1"}},
+		{"no tags", nil, map[string]string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := tagPolicyResourceTags(test.tags); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("tagPolicyResourceTags() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
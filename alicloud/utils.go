@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
@@ -289,3 +290,32 @@ func (filters *QueryFilters) String() (string, error) {
 
 	return string(data), nil
 }
+
+// bssTagsToMap converts the tags of a bill, of the form
+// "key:k1 value:v1; key:k2 value:v2", into a map
+func bssTagsToMap(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags := types.SafeString(d.Value)
+	if tags == "" {
+		return nil, nil
+	}
+
+	turbotTags := map[string]string{}
+	for _, tag := range strings.Split(tags, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(tag), " value:")
+		key = strings.TrimPrefix(key, "key:")
+		if key != "" {
+			turbotTags[key] = value
+		}
+	}
+	return turbotTags, nil
+}
+
+// bssAmountToDouble converts an amount returned as a string by the billing
+// APIs, such as "1,234.56", into a number
+func bssAmountToDouble(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	amount := strings.ReplaceAll(types.SafeString(d.Value), ",", "")
+	if amount == "" {
+		return nil, nil
+	}
+	return strconv.ParseFloat(amount, 64)
+}